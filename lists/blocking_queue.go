@@ -0,0 +1,201 @@
+package lists
+
+import (
+	"context"
+	"sync"
+)
+
+// NewBlockingQueue returns a new, unbounded, BlockingQueue with the given elements
+func NewBlockingQueue[T any](elements ...T) *BlockingQueue[T] {
+	q := &BlockingQueue[T]{notEmpty: make(chan struct{}), notFull: make(chan struct{})}
+	q.d.PushBack(elements...)
+	return q
+}
+
+// NewBoundedBlockingQueue returns a new BlockingQueue which holds at most the given capacity of elements.
+// If capacity is not greater than zero, panics with ErrInvalidSize.
+func NewBoundedBlockingQueue[T any](capacity int) *BlockingQueue[T] {
+	if capacity <= 0 {
+		panic(ErrInvalidSize)
+	}
+	q := NewBlockingQueue[T]()
+	q.capacity = capacity
+	return q
+}
+
+// BlockingQueue is a thread-safe first-in-first-out queue whose consumers wait for elements, instead of polling.
+// Take waits while it is empty, and, when bounded (see NewBoundedBlockingQueue), Put waits while it is full, applying backpressure on producers.
+// Their Context variants stop waiting once the context is done.
+//
+// After Close, Put fails with ErrClosed, and Take keeps returning the remaining elements, failing with ErrClosed once it is empty.
+type BlockingQueue[T any] struct {
+	mu       sync.Mutex
+	d        Deque[T]
+	capacity int
+	closed   bool
+	notEmpty chan struct{}
+	notFull  chan struct{}
+}
+
+// Length returns how many elements are in the BlockingQueue.
+func (q *BlockingQueue[T]) Length() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.d.Length()
+}
+
+// IsEmpty returns true if there are *no* elements stored in the BlockingQueue.
+func (q *BlockingQueue[T]) IsEmpty() bool {
+	return q.Length() == 0
+}
+
+// Capacity returns how many elements the BlockingQueue holds at most, or 0 if it is unbounded.
+func (q *BlockingQueue[T]) Capacity() int {
+	return q.capacity
+}
+
+// IsClosed returns true if the BlockingQueue was closed (see Close).
+func (q *BlockingQueue[T]) IsClosed() bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.closed
+}
+
+// Put adds the given element at the tail of the BlockingQueue, waiting while it is full.
+// If the BlockingQueue is closed, ErrClosed is returned.
+func (q *BlockingQueue[T]) Put(element T) error {
+	return q.PutContext(context.Background(), element)
+}
+
+// PutContext adds the given element at the tail of the BlockingQueue, waiting while it is full.
+// If the BlockingQueue is closed, ErrClosed is returned. If the context is done first, the context error is returned.
+func (q *BlockingQueue[T]) PutContext(ctx context.Context, element T) error {
+	q.mu.Lock()
+	for !q.closed && q.isFull() {
+		if err := q.wait(ctx, q.notFull); err != nil {
+			return err
+		}
+	}
+	defer q.mu.Unlock()
+	if q.closed {
+		return ErrClosed
+	}
+	q.d.PushBack(element)
+	broadcast(&q.notEmpty)
+	return nil
+}
+
+// Take removes the element at the head of the BlockingQueue and returns it, waiting while it is empty.
+// If the BlockingQueue is closed and empty, ErrClosed is returned.
+func (q *BlockingQueue[T]) Take() (T, error) {
+	return q.TakeContext(context.Background())
+}
+
+// TakeContext removes the element at the head of the BlockingQueue and returns it, waiting while it is empty.
+// If the BlockingQueue is closed and empty, ErrClosed is returned. If the context is done first, the context error is returned.
+func (q *BlockingQueue[T]) TakeContext(ctx context.Context) (T, error) {
+	q.mu.Lock()
+	for !q.closed && q.d.IsEmpty() {
+		if err := q.wait(ctx, q.notEmpty); err != nil {
+			var zero T
+			return zero, err
+		}
+	}
+	defer q.mu.Unlock()
+	v, ok := q.d.PopFront()
+	if !ok {
+		return v, ErrClosed
+	}
+	broadcast(&q.notFull)
+	return v, nil
+}
+
+// DrainTo removes at most limit elements from the head of the BlockingQueue, without waiting, and pushes them to the given IList, in order.
+// If limit is not greater than zero, all elements are removed. It returns how many elements were removed.
+// Fixed-capacity lists (see FixedList) receive at most as many elements as they have room for.
+// The IList is pushed after the BlockingQueue is unlocked, so it may use the BlockingQueue. If its Push panics,
+// the elements are put back at the head of the BlockingQueue before the panic goes on.
+func (q *BlockingQueue[T]) DrainTo(list IList[T], limit int) int {
+	if f, fixed := list.(interface{ Remaining() int }); fixed {
+		room := f.Remaining()
+		if room <= 0 {
+			return 0
+		}
+		if limit <= 0 || limit > room {
+			limit = room
+		}
+	}
+	drained := q.take(limit)
+	if len(drained) == 0 {
+		return 0
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			q.restore(drained)
+			panic(r)
+		}
+	}()
+	list.Push(drained...)
+	return len(drained)
+}
+
+// Close closes the BlockingQueue, waking all waiting producers and consumers. Closing it again does nothing.
+func (q *BlockingQueue[T]) Close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if !q.closed {
+		q.closed = true
+		broadcast(&q.notEmpty)
+		broadcast(&q.notFull)
+	}
+}
+
+// take removes at most limit elements from the head of the BlockingQueue, without waiting, and returns them.
+// If limit is not greater than zero, all elements are removed.
+func (q *BlockingQueue[T]) take(limit int) []T {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if limit <= 0 || limit > q.d.Length() {
+		limit = q.d.Length()
+	}
+	taken := make([]T, limit)
+	for i := range taken {
+		taken[i], _ = q.d.PopFront()
+	}
+	if limit > 0 {
+		broadcast(&q.notFull)
+	}
+	return taken
+}
+
+// restore puts the given elements back at the head of the BlockingQueue, in order, even if it is full or closed.
+func (q *BlockingQueue[T]) restore(elements []T) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.d.PushFront(elements...)
+	broadcast(&q.notEmpty)
+}
+
+// isFull returns true if the BlockingQueue is bounded and has no room left. It must be called while locked.
+func (q *BlockingQueue[T]) isFull() bool {
+	return q.capacity > 0 && q.d.Length() >= q.capacity
+}
+
+// wait unlocks the BlockingQueue until the given signal is broadcast, and then locks it again.
+// If the context is done first, it returns the context error, leaving the BlockingQueue unlocked.
+func (q *BlockingQueue[T]) wait(ctx context.Context, signal chan struct{}) error {
+	q.mu.Unlock()
+	select {
+	case <-signal:
+		q.mu.Lock()
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// broadcast wakes everyone waiting on the given signal, by closing it and replacing it with a new one.
+func broadcast(signal *chan struct{}) {
+	close(*signal)
+	*signal = make(chan struct{})
+}
@@ -0,0 +1,205 @@
+package lists
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestBlockingQueue_ProducersAndConsumers(t *testing.T) {
+	const producers, consumers, elements = 8, 8, 1000
+	q := NewBoundedBlockingQueue[int](16)
+	var (
+		produced, consumed sync.WaitGroup
+		sum, count         atomic.Int64
+	)
+	for p := 0; p < producers; p++ {
+		produced.Add(1)
+		go func() {
+			defer produced.Done()
+			for i := 1; i <= elements; i++ {
+				if err := q.Put(i); err != nil {
+					t.Error(err)
+				}
+			}
+		}()
+	}
+	for c := 0; c < consumers; c++ {
+		consumed.Add(1)
+		go func() {
+			defer consumed.Done()
+			for {
+				v, err := q.Take()
+				if errors.Is(err, ErrClosed) {
+					return
+				}
+				if q.Length() > q.Capacity() {
+					t.Errorf("BlockingQueue should not exceed its capacity. Got: %v", q.Length())
+				}
+				sum.Add(int64(v))
+				count.Add(1)
+			}
+		}()
+	}
+	produced.Wait()
+	q.Close()
+	consumed.Wait()
+	if count.Load() != producers*elements || sum.Load() != producers*elements*(elements+1)/2 {
+		t.Errorf("BlockingQueue should deliver every element once. Got %v elements", count.Load())
+	}
+}
+
+func TestBlockingQueue_Order(t *testing.T) {
+	q := NewBlockingQueue(1, 2)
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		q.Put(3)
+	}()
+	for i := 1; i <= 3; i++ {
+		if v, err := q.Take(); v != i || err != nil {
+			t.Errorf("Take should return the elements in order. Expected %v. Got: %v, %v", i, v, err)
+		}
+	}
+}
+
+func TestBlockingQueue_Backpressure(t *testing.T) {
+	q := NewBoundedBlockingQueue[int](1)
+	q.Put(1)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := q.PutContext(ctx, 2); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("PutContext should wait while full, until the context is done. Got: %v", err)
+	}
+	done := make(chan error)
+	go func() {
+		done <- q.Put(2)
+	}()
+	if v, _ := q.Take(); v != 1 {
+		t.Errorf("Take should return the first element. Got: %v", v)
+	}
+	if err := <-done; err != nil || q.Length() != 1 {
+		t.Errorf("Put should resume once there is room. Got: %v", err)
+	}
+}
+
+func TestBlockingQueue_Cancel(t *testing.T) {
+	q := NewBlockingQueue[int]()
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+	if _, err := q.TakeContext(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("TakeContext should stop waiting when cancelled. Got: %v", err)
+	}
+	q.Put(1)
+	if v, err := q.Take(); v != 1 || err != nil {
+		t.Errorf("BlockingQueue should work after a cancelled Take. Got: %v, %v", v, err)
+	}
+}
+
+func TestBlockingQueue_Close(t *testing.T) {
+	q := NewBoundedBlockingQueue[int](1)
+	q.Put(1)
+	var wg sync.WaitGroup
+	var closedPuts atomic.Int64
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if errors.Is(q.Put(2), ErrClosed) {
+				closedPuts.Add(1)
+			}
+		}()
+	}
+	time.Sleep(10 * time.Millisecond)
+	q.Close()
+	q.Close()
+	wg.Wait()
+	if closedPuts.Load() != 4 || !q.IsClosed() {
+		t.Errorf("Close should wake waiting producers with ErrClosed. Got %v", closedPuts.Load())
+	}
+	if v, err := q.Take(); v != 1 || err != nil {
+		t.Errorf("Take should return remaining elements after Close. Got: %v, %v", v, err)
+	}
+	if _, err := q.Take(); !errors.Is(err, ErrClosed) {
+		t.Errorf("Take should return ErrClosed once closed and empty. Got: %v", err)
+	}
+	waiting := NewBlockingQueue[int]()
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		waiting.Close()
+	}()
+	if _, err := waiting.Take(); !errors.Is(err, ErrClosed) {
+		t.Errorf("Close should wake waiting consumers with ErrClosed. Got: %v", err)
+	}
+}
+
+func TestBlockingQueue_DrainTo(t *testing.T) {
+	q := NewBlockingQueue(1, 2, 3, 4, 5)
+	list := NewSafeList(0)
+	if drained := q.DrainTo(list, 2); drained != 2 || list.Join(",") != "0,1,2" {
+		t.Errorf("DrainTo should move at most the given amount of elements. Got %v: %v", drained, list)
+	}
+	if drained := q.DrainTo(list, 0); drained != 3 || list.Join(",") != "0,1,2,3,4,5" || !q.IsEmpty() {
+		t.Errorf("DrainTo should move all elements. Got %v: %v", drained, list)
+	}
+	if drained := q.DrainTo(list, 10); drained != 0 || list.Length() != 6 {
+		t.Errorf("DrainTo should move nothing when empty. Got %v", drained)
+	}
+}
+
+// rejecting is an IList whose Push always panics with ErrCapacityExceeded.
+type rejecting[T any] struct {
+	*List[T]
+}
+
+func (r rejecting[T]) Push(...T) IList[T] {
+	panic(ErrCapacityExceeded)
+}
+
+func TestBlockingQueue_DrainToFixed(t *testing.T) {
+	q := NewBoundedBlockingQueue[int](4)
+	for i := 1; i <= 4; i++ {
+		q.Put(i)
+	}
+	list := NewFixedList(3, 0)
+	if drained := q.DrainTo(list, 0); drained != 2 || list.Join(",") != "0,1,2" || q.Length() != 2 {
+		t.Errorf("DrainTo should move only what fits in a FixedList. Got %v: %v", drained, list)
+	}
+	if drained := q.DrainTo(list, 0); drained != 0 || q.Length() != 2 {
+		t.Errorf("DrainTo should move nothing to a full FixedList. Got %v", drained)
+	}
+	func() {
+		defer func() {
+			if r := recover(); r != ErrCapacityExceeded {
+				t.Errorf("DrainTo should let Push panics go on. Got: %v", r)
+			}
+		}()
+		q.DrainTo(rejecting[int]{NewList[int]()}, 0)
+	}()
+	if v, err := q.Take(); v != 3 || err != nil || q.Length() != 1 {
+		t.Errorf("DrainTo should put the elements back when Push panics. Got: %v, %v", v, err)
+	}
+	q.Put(5)
+	observed := NewObservableList[int](NewList[int]())
+	observed.OnChange(func(event Event[int]) {
+		// listeners may use the BlockingQueue, as it is not locked while pushing
+		q.Length()
+	})
+	if drained := q.DrainTo(observed, 0); drained != 2 || observed.Join(",") != "4,5" {
+		t.Errorf("DrainTo should push after unlocking the BlockingQueue. Got %v: %v", drained, observed)
+	}
+}
+
+func TestBlockingQueue_InvalidCapacity(t *testing.T) {
+	defer func() {
+		if r := recover(); r != ErrInvalidSize {
+			t.Errorf("NewBoundedBlockingQueue should panic with ErrInvalidSize. Got: %v", r)
+		}
+	}()
+	NewBoundedBlockingQueue[int](0)
+}
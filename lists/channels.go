@@ -0,0 +1,101 @@
+package lists
+
+import (
+	"context"
+	"sync"
+)
+
+// FromChannel returns a new List with the elements received from the given channel, in order, until it is closed.
+// If the context is done first, the List with the elements received so far is returned, along with the context error.
+func FromChannel[T any](ctx context.Context, ch <-chan T) (IList[T], error) {
+	list := NewList[T]()
+	for {
+		select {
+		case v, ok := <-ch:
+			if !ok {
+				return list, nil
+			}
+			list.Push(v)
+		case <-ctx.Done():
+			return list, ctx.Err()
+		}
+	}
+}
+
+// Stream returns a channel which receives the elements of the given IList, in order, from a new goroutine.
+// The channel is closed after the last element, or as soon as the context is done, so the goroutine never outlives it.
+// Thread-safe implementations are read through a consistent copy, taken before Stream returns.
+func Stream[T any](ctx context.Context, list IList[T]) <-chan T {
+	elements := elementsOf(list)
+	ch := make(chan T)
+	go func() {
+		defer close(ch)
+		send(ctx, elements, ch)
+	}()
+	return ch
+}
+
+// ToChannel sends the elements of the given IList to the given channel, in order, and returns after the last one is sent.
+// If the context is done first, the remaining elements are not sent and the context error is returned.
+// The channel is not closed. Thread-safe implementations are read through a consistent copy.
+func ToChannel[T any](ctx context.Context, list IList[T], ch chan<- T) error {
+	return send(ctx, elementsOf(list), ch)
+}
+
+// send sends the given elements to the given channel, in order, until the context is done.
+func send[T any](ctx context.Context, elements []T, ch chan<- T) error {
+	for _, v := range elements {
+		select {
+		case ch <- v:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// subscriber queues the elements pushed to a SafeList, to be delivered to its channel by its own goroutine.
+// Its queue is unbounded, so enqueueing never waits for the receiver.
+type subscriber[T any] struct {
+	mu     sync.Mutex
+	queue  []T
+	signal chan struct{}
+}
+
+func newSubscriber[T any]() *subscriber[T] {
+	return &subscriber[T]{signal: make(chan struct{}, 1)}
+}
+
+// enqueue adds the given elements to the queue, and wakes up the delivery goroutine.
+func (s *subscriber[T]) enqueue(elements []T) {
+	s.mu.Lock()
+	s.queue = append(s.queue, elements...)
+	s.mu.Unlock()
+	select {
+	case s.signal <- struct{}{}:
+	default:
+	}
+}
+
+// dequeue removes and returns all the queued elements.
+func (s *subscriber[T]) dequeue() []T {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	queued := s.queue
+	s.queue = nil
+	return queued
+}
+
+// deliver sends the queued elements to the given channel, in order, until the context is done.
+func (s *subscriber[T]) deliver(ctx context.Context, ch chan<- T) {
+	for {
+		if send(ctx, s.dequeue(), ch) != nil {
+			return
+		}
+		select {
+		case <-s.signal:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
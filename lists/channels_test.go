@@ -0,0 +1,166 @@
+package lists
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"testing"
+	"time"
+)
+
+// settled waits for background goroutines to exit, and returns true if they are back to the given amount.
+func settled(goroutines int) bool {
+	for i := 0; i < 100; i++ {
+		if runtime.NumGoroutine() <= goroutines {
+			return true
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return false
+}
+
+func TestFromChannel(t *testing.T) {
+	ch := make(chan int, 3)
+	ch <- 1
+	ch <- 2
+	ch <- 3
+	close(ch)
+	list, err := FromChannel(context.Background(), ch)
+	if err != nil || list.Join(",") != "1,2,3" {
+		t.Errorf("FromChannel should collect until the channel is closed. Got: %v, %v", list, err)
+	}
+}
+
+func TestFromChannel_Cancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := make(chan int)
+	go func() {
+		ch <- 1
+		ch <- 2
+		cancel()
+	}()
+	list, err := FromChannel(ctx, ch)
+	if !errors.Is(err, context.Canceled) || list.Join(",") != "1,2" {
+		t.Errorf("FromChannel should return the collected elements when cancelled. Got: %v, %v", list, err)
+	}
+}
+
+func TestStream(t *testing.T) {
+	list := NewSafeList(1, 2, 3)
+	var received []int
+	for v := range Stream[int](context.Background(), list) {
+		list.Push(v)
+		received = append(received, v)
+	}
+	if fmtInts(received) != "1,2,3" || list.Length() != 6 {
+		t.Errorf("Stream should send a snapshot of the elements. Got: %v and %v", received, list)
+	}
+}
+
+func TestStream_Cancel(t *testing.T) {
+	goroutines := runtime.NumGoroutine()
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := Stream[int](ctx, NewList(1, 2, 3))
+	if v := <-ch; v != 1 {
+		t.Errorf("Stream should send the first element. Got: %v", v)
+	}
+	cancel()
+	for range ch {
+	}
+	if !settled(goroutines) {
+		t.Error("Stream should not leak its goroutine after cancellation")
+	}
+}
+
+func TestToChannel(t *testing.T) {
+	ch := make(chan int, 3)
+	if err := ToChannel[int](context.Background(), NewList(1, 2, 3), ch); err != nil {
+		t.Error(err)
+	}
+	close(ch)
+	if list, _ := FromChannel(context.Background(), ch); list.Join(",") != "1,2,3" {
+		t.Errorf("ToChannel should send every element. Got: %v", list)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := ToChannel[int](ctx, NewList(1), make(chan int)); !errors.Is(err, context.Canceled) {
+		t.Errorf("ToChannel should return the context error. Got: %v", err)
+	}
+}
+
+func TestSafeList_Subscribe(t *testing.T) {
+	goroutines := runtime.NumGoroutine()
+	list := NewSafeList(1, 2)
+	ctx, cancel := context.WithCancel(context.Background())
+	slow := list.Subscribe(ctx)
+	fast := list.Subscribe(ctx)
+	for i := 3; i <= 100; i++ {
+		list.Push(i)
+	}
+	for i := 3; i <= 100; i++ {
+		if v := <-fast; v != i {
+			t.Fatalf("Subscribe should deliver pushed elements in order. Expected %v. Got: %v", i, v)
+		}
+	}
+	if v := <-slow; v != 3 {
+		t.Errorf("Subscribe should queue elements for slow subscribers. Got: %v", v)
+	}
+	cancel()
+	for range slow {
+	}
+	for range fast {
+	}
+	if !settled(goroutines) {
+		t.Error("Subscribe should not leak its goroutine after cancellation")
+	}
+	list.Push(101)
+	if list.Length() != 101 || len(list.subscribers) != 0 {
+		t.Errorf("SafeList should drop cancelled subscriptions. Got %v subscribers", len(list.subscribers))
+	}
+}
+
+func TestSafeFixedList_Subscribe(t *testing.T) {
+	list := NewSafeFixedList[int](2)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ch := list.Subscribe(ctx)
+	list.Push(1, 2)
+	if a, b := <-ch, <-ch; a != 1 || b != 2 {
+		t.Errorf("SafeFixedList should deliver pushed elements. Got: %v, %v", a, b)
+	}
+}
+
+func TestSafeFixedList_SubscribeOverflow(t *testing.T) {
+	// received collects the delivered elements, until none arrives for a while
+	received := func(ch <-chan int) string {
+		delivered := NewList[int]()
+		for {
+			select {
+			case v := <-ch:
+				delivered.Push(v)
+			case <-time.After(50 * time.Millisecond):
+				return delivered.Join(",")
+			}
+		}
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	discard := NewSafeFixedList[int](3).OnOverflow(OverflowDiscard)
+	discarded := discard.Subscribe(ctx)
+	discard.Push(1, 2)
+	discard.Push(3, 4, 5)
+	if err := discard.TryPush(6); err == nil || discard.Join(",") != "1,2,3" {
+		t.Errorf("SafeFixedList should discard what does not fit. Got: %v", discard)
+	}
+	if got := received(discarded); got != "1,2,3" {
+		t.Errorf("Subscribe should not deliver discarded elements. Got: %v", got)
+	}
+	evict := NewSafeFixedList[int](3).OnOverflow(OverflowEvict)
+	evicted := evict.Subscribe(ctx)
+	evict.Push(1, 2)
+	evict.Push(3, 4)
+	evict.Push(5, 6, 7, 8)
+	if got := received(evicted); evict.Join(",") != "6,7,8" || got != "1,2,3,4,6,7,8" {
+		t.Errorf("Subscribe should deliver only the stored elements. Got: %v for %v", got, evict)
+	}
+}
@@ -0,0 +1,73 @@
+package lists
+
+// Chunk returns an IList with the elements of the given IList split in consecutive Lists of the given size.
+// The last chunk may be smaller. If size is not greater than zero, panics with ErrInvalidSize.
+func Chunk[T any](list IList[T], size int) IList[IList[T]] {
+	return Window(list, size, size, true)
+}
+
+// Window returns an IList with Lists of the given size, sliding over the elements of the given IList by step elements each.
+// e.g. Window of [1 2 3 4 5] with size 3 and step 1 is [[1 2 3] [2 3 4] [3 4 5]].
+// If partial is true, the trailing windows smaller than size are kept, otherwise only full windows are returned.
+// If size or step is not greater than zero, panics with ErrInvalidSize.
+func Window[T any](list IList[T], size, step int, partial bool) IList[IList[T]] {
+	if size <= 0 || step <= 0 {
+		panic(ErrInvalidSize)
+	}
+	elements := elementsOf(list)
+	windows := NewList[IList[T]]()
+	for start := 0; start < len(elements); start += step {
+		end := start + size
+		if end > len(elements) {
+			if !partial {
+				break
+			}
+			end = len(elements)
+		}
+		windows.Push(NewList(elements[start:end]...))
+		if end == len(elements) {
+			break
+		}
+	}
+	return windows
+}
+
+// ChunkBy returns an IList with the elements of the given IList split in consecutive Lists.
+// A new chunk starts whenever the boundary function returns true for a pair of adjacent elements (the previous and the current one).
+// e.g. ChunkBy of [1 2 4 5 7] with a boundary of current-previous > 1 is [[1 2] [4 5] [7]].
+func ChunkBy[T any](list IList[T], boundary func(previous, current T) bool) IList[IList[T]] {
+	elements := elementsOf(list)
+	chunks := NewList[IList[T]]()
+	start := 0
+	for i := 1; i <= len(elements); i++ {
+		if i == len(elements) || boundary(elements[i-1], elements[i]) {
+			chunks.Push(NewList(elements[start:i]...))
+			start = i
+		}
+	}
+	return chunks
+}
+
+// ForEachBatch calls the given function with consecutive batches of the given size from the given IList, in order.
+// The last batch may be smaller. It stops at the first error returned by the function, and returns it.
+// Batches are streamed through a single List, reused between calls, so chunks are never all built in memory:
+// the function must not keep the batch after returning (see Clone).
+// If size is not greater than zero, panics with ErrInvalidSize.
+func ForEachBatch[T any](list IList[T], size int, handler func(batch IList[T]) error) error {
+	if size <= 0 {
+		panic(ErrInvalidSize)
+	}
+	elements := elementsOf(list)
+	batch := make(List[T], 0, size)
+	for start := 0; start < len(elements); start += size {
+		end := start + size
+		if end > len(elements) {
+			end = len(elements)
+		}
+		batch = append(batch[:0], elements[start:end]...)
+		if err := handler(&batch); err != nil {
+			return err
+		}
+	}
+	return nil
+}
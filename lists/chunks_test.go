@@ -0,0 +1,64 @@
+package lists
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestChunk(t *testing.T) {
+	chunks := Chunk[int](NewSafeList(1, 2, 3, 4, 5), 2)
+	if chunks.String() != "[[1 2] [3 4] [5]]" {
+		t.Errorf("Chunk: expected [[1 2] [3 4] [5]]. Got: %v", chunks)
+	}
+	if Chunk[int](NewList[int](), 2).IsNotEmpty() {
+		t.Error("Chunk of an empty list should be empty")
+	}
+}
+
+func TestWindow(t *testing.T) {
+	list := NewList(1, 2, 3, 4, 5)
+	if windows := Window[int](list, 3, 1, false); windows.String() != "[[1 2 3] [2 3 4] [3 4 5]]" {
+		t.Errorf("Window: expected [[1 2 3] [2 3 4] [3 4 5]]. Got: %v", windows)
+	}
+	if windows := Window[int](list, 2, 3, true); windows.String() != "[[1 2] [4 5]]" {
+		t.Errorf("Window: expected [[1 2] [4 5]]. Got: %v", windows)
+	}
+	if windows := Window[int](list, 3, 2, true); windows.String() != "[[1 2 3] [3 4 5]]" {
+		t.Errorf("Window: expected [[1 2 3] [3 4 5]]. Got: %v", windows)
+	}
+	if windows := Window[int](list, 6, 1, false); windows.IsNotEmpty() {
+		t.Errorf("Window larger than the list should be empty. Got: %v", windows)
+	}
+}
+
+func TestChunkBy(t *testing.T) {
+	chunks := ChunkBy[int](NewList(1, 2, 4, 5, 7), func(previous, current int) bool {
+		return current-previous > 1
+	})
+	if chunks.String() != "[[1 2] [4 5] [7]]" {
+		t.Errorf("ChunkBy: expected [[1 2] [4 5] [7]]. Got: %v", chunks)
+	}
+}
+
+func TestForEachBatch(t *testing.T) {
+	var batches []string
+	stop := errors.New("stop")
+	err := ForEachBatch[int](NewSafeList(1, 2, 3, 4, 5, 6, 7), 3, func(batch IList[int]) error {
+		batches = append(batches, batch.String())
+		if len(batches) == 2 {
+			return stop
+		}
+		return nil
+	})
+	if !errors.Is(err, stop) || fmt.Sprint(batches) != "[[1 2 3] [4 5 6]]" {
+		t.Errorf("ForEachBatch should stop at the first error. Got: %v, %v", batches, err)
+	}
+	batches = nil
+	if err = ForEachBatch[int](NewList(1, 2, 3), 2, func(batch IList[int]) error {
+		batches = append(batches, batch.String())
+		return nil
+	}); err != nil || fmt.Sprint(batches) != "[[1 2] [3]]" {
+		t.Errorf("ForEachBatch: expected [[1 2] [3]]. Got: %v, %v", batches, err)
+	}
+}
@@ -0,0 +1,428 @@
+package lists
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"sync/atomic"
+)
+
+// NewCopyOnWriteList returns a new CopyOnWriteList with the given elements
+func NewCopyOnWriteList[T any](elements ...T) *CopyOnWriteList[T] {
+	c := &CopyOnWriteList[T]{}
+	c.Push(elements...)
+	return c
+}
+
+// NewCopyOnWriteListFrom returns a new CopyOnWriteList with the given slice
+func NewCopyOnWriteListFrom[T any](elements []T) *CopyOnWriteList[T] {
+	return NewCopyOnWriteList(elements...)
+}
+
+// CopyOnWriteList is a dynamically-sized and thread-safe implementation of IList, optimized for lists which are read much more often than written.
+// Its elements are kept in an immutable slice, which is atomically swapped on each write, so reads never take a lock.
+// Each write copies the whole slice, and writers are serialized among themselves.
+// Elements, and the pointers returned by methods such as At and First, never alias the internal slice:
+// changing them does not change the CopyOnWriteList (use Set instead).
+type CopyOnWriteList[T any] struct {
+	elements atomic.Pointer[List[T]]
+	writer   sync.Mutex
+}
+
+// view returns the currently published slice as a List. It must never be changed.
+func (c *CopyOnWriteList[T]) view() *List[T] {
+	if current := c.elements.Load(); current != nil {
+		return current
+	}
+	return &List[T]{}
+}
+
+// write copies the published slice, changes the copy with the given function, and then publishes it.
+// If the change fails, nothing is published, and the error is returned.
+func (c *CopyOnWriteList[T]) write(change func(*List[T]) error) error {
+	c.writer.Lock()
+	defer c.writer.Unlock()
+	current := c.view().Elements()
+	next := make(List[T], len(current), len(current)+1)
+	copy(next, current)
+	if err := change(&next); err != nil {
+		return err
+	}
+	c.elements.Store(&next)
+	return nil
+}
+
+// detach returns a pointer to a copy of the given element, or nil.
+func detach[T any](element *T) *T {
+	if element == nil {
+		return nil
+	}
+	copied := *element
+	return &copied
+}
+
+// Length returns how many elements are in the CopyOnWriteList.
+func (c *CopyOnWriteList[T]) Length() int {
+	return c.view().Length()
+}
+
+// IsEmpty returns true if there are *no* Elements stored in the CopyOnWriteList.
+func (c *CopyOnWriteList[T]) IsEmpty() bool {
+	return c.view().IsEmpty()
+}
+
+// IsNotEmpty returns true if there are Elements stored in the CopyOnWriteList.
+func (c *CopyOnWriteList[T]) IsNotEmpty() bool {
+	return c.view().IsNotEmpty()
+}
+
+// At returns a pointer to a copy of the element at the given index from the CopyOnWriteList.
+// If there is no element at the given index, nil will be returned.
+func (c *CopyOnWriteList[T]) At(i int) *T {
+	return detach(c.view().At(i))
+}
+
+// AtFromEnd returns a pointer to a copy of the element at the given offset from the end of the CopyOnWriteList: AtFromEnd(0) is the last element.
+// If there is no element at the given offset, nil will be returned.
+func (c *CopyOnWriteList[T]) AtFromEnd(i int) *T {
+	return detach(c.view().AtFromEnd(i))
+}
+
+// ElementAt returns the element at the given index from the CopyOnWriteList.
+// If there is no element at the given index, panics.
+func (c *CopyOnWriteList[T]) ElementAt(i int) T {
+	return c.view().ElementAt(i)
+}
+
+// TryElementAt returns the element at the given index from the CopyOnWriteList.
+// If there is no element at the given index, ErrIndexOutOfRange is returned.
+func (c *CopyOnWriteList[T]) TryElementAt(i int) (T, error) {
+	return c.view().TryElementAt(i)
+}
+
+// Elements returns a copy of the built-in slice with all elements in the CopyOnWriteList.
+func (c *CopyOnWriteList[T]) Elements() []T {
+	current := c.view().Elements()
+	elements := make([]T, len(current))
+	copy(elements, current)
+	return elements
+}
+
+// Push add the given elements in the CopyOnWriteList, and then returns itself.
+func (c *CopyOnWriteList[T]) Push(elements ...T) IList[T] {
+	c.write(func(l *List[T]) error {
+		l.Push(elements...)
+		return nil
+	})
+	return c
+}
+
+// Clone returns an identical CopyOnWriteList from the original.
+// As published slices are never changed, both lists share it until the next write, so Clone runs in O(1).
+func (c *CopyOnWriteList[T]) Clone() IList[T] {
+	cloned := &CopyOnWriteList[T]{}
+	cloned.elements.Store(c.view())
+	return cloned
+}
+
+// FirstElement returns the first element in the CopyOnWriteList.
+// If CopyOnWriteList is empty (see IsEmpty), panics
+func (c *CopyOnWriteList[T]) FirstElement() T {
+	return c.view().FirstElement()
+}
+
+// TryFirstElement returns the first element in the CopyOnWriteList.
+// If CopyOnWriteList is empty (see IsEmpty), ErrEmpty is returned.
+func (c *CopyOnWriteList[T]) TryFirstElement() (T, error) {
+	return c.view().TryFirstElement()
+}
+
+// First returns a pointer to a copy of the first element in the CopyOnWriteList.
+// If CopyOnWriteList is empty (see IsEmpty), nil will be returned.
+func (c *CopyOnWriteList[T]) First() *T {
+	return detach(c.view().First())
+}
+
+// LastElement returns the last element in the CopyOnWriteList.
+// If CopyOnWriteList is empty (see IsEmpty), panics.
+func (c *CopyOnWriteList[T]) LastElement() T {
+	return c.view().LastElement()
+}
+
+// TryLastElement returns the last element in the CopyOnWriteList.
+// If CopyOnWriteList is empty (see IsEmpty), ErrEmpty is returned.
+func (c *CopyOnWriteList[T]) TryLastElement() (T, error) {
+	return c.view().TryLastElement()
+}
+
+// Last returns a pointer to a copy of the last element in the CopyOnWriteList.
+// If CopyOnWriteList is empty (see IsEmpty), nil will be returned.
+func (c *CopyOnWriteList[T]) Last() *T {
+	return detach(c.view().Last())
+}
+
+// FirstIndexWhere returns the index of the first element which satisfies the predicate.
+// If no element satisfies the predicate, -1 will be returned.
+func (c *CopyOnWriteList[T]) FirstIndexWhere(handler Predicate[T]) int {
+	return c.view().FirstIndexWhere(handler)
+}
+
+// FirstWhere returns a pointer to a copy of the first element which satisfies the predicate.
+// If no element satisfies the predicate, nil will be returned.
+func (c *CopyOnWriteList[T]) FirstWhere(handler Predicate[T]) *T {
+	return detach(c.view().FirstWhere(handler))
+}
+
+// FirstElementWhere returns the first element which satisfies the predicate.
+// If no element satisfies the predicate, panics.
+func (c *CopyOnWriteList[T]) FirstElementWhere(handler Predicate[T]) T {
+	return c.view().FirstElementWhere(handler)
+}
+
+// TryFirstElementWhere returns the first element which satisfies the predicate.
+// If no element satisfies the predicate, ErrNotFound is returned.
+func (c *CopyOnWriteList[T]) TryFirstElementWhere(handler Predicate[T]) (T, error) {
+	return c.view().TryFirstElementWhere(handler)
+}
+
+// LastIndexWhere returns the index of the last element which satisfies the predicate.
+// If no element satisfies the predicate, -1 will be returned.
+func (c *CopyOnWriteList[T]) LastIndexWhere(handler Predicate[T]) int {
+	return c.view().LastIndexWhere(handler)
+}
+
+// LastWhere returns a pointer to a copy of the last element which satisfies the predicate.
+// If no element satisfies the predicate, nil will be returned.
+func (c *CopyOnWriteList[T]) LastWhere(handler Predicate[T]) *T {
+	return detach(c.view().LastWhere(handler))
+}
+
+// LastElementWhere returns the last element which satisfies the predicate.
+// If no element satisfies the predicate, panics.
+func (c *CopyOnWriteList[T]) LastElementWhere(handler Predicate[T]) T {
+	return c.view().LastElementWhere(handler)
+}
+
+// TryLastElementWhere returns the last element which satisfies the predicate.
+// If no element satisfies the predicate, ErrNotFound is returned.
+func (c *CopyOnWriteList[T]) TryLastElementWhere(handler Predicate[T]) (T, error) {
+	return c.view().TryLastElementWhere(handler)
+}
+
+// IndexWhere returns a List[int] for all element index which satisfies the predicate.
+// If no element satisfies the predicate, an empty List will be returned.
+func (c *CopyOnWriteList[T]) IndexWhere(handler Predicate[T]) IList[int] {
+	return c.view().IndexWhere(handler)
+}
+
+// Where returns a List with all the elements which satisfies the predicate.
+// If no element satisfies the predicate, an empty List will be returned.
+func (c *CopyOnWriteList[T]) Where(handler Predicate[T]) IList[T] {
+	return c.view().Where(handler)
+}
+
+// Map iterates over the element of the CopyOnWriteList calling Mapper, and return a new List with the results.
+func (c *CopyOnWriteList[T]) Map(handler Mapper[T]) IList[any] {
+	return c.view().Map(handler)
+}
+
+// Reduce executes the Reducer for each element from the list with the given accumulator, and each result will be the accumulator for the next.
+// The final result will be returned.
+func (c *CopyOnWriteList[T]) Reduce(reducer Reducer[T], accumulator any) any {
+	return c.view().Reduce(reducer, accumulator)
+}
+
+// Every returns true if every element in the IList satisfies the predicate.
+func (c *CopyOnWriteList[T]) Every(handler Predicate[T]) bool {
+	return c.view().Every(handler)
+}
+
+// Some returns true if at least one element in the IList satisfies the predicate.
+func (c *CopyOnWriteList[T]) Some(handler Predicate[T]) bool {
+	return c.view().Some(handler)
+}
+
+// None returns true no element in the IList satisfy the predicate.
+func (c *CopyOnWriteList[T]) None(handler Predicate[T]) bool {
+	return c.view().None(handler)
+}
+
+// ForEach calls the Consumer with each element of the IList and its index, in order.
+func (c *CopyOnWriteList[T]) ForEach(handler Consumer[T]) {
+	c.view().ForEach(handler)
+}
+
+// ForEachUntil calls the IndexedPredicate with each element of the IList and its index, in order, until it returns true.
+func (c *CopyOnWriteList[T]) ForEachUntil(handler IndexedPredicate[T]) {
+	c.view().ForEachUntil(handler)
+}
+
+// ForEachContext calls the Consumer with each element of the IList and its index, in order, checking the context before each call.
+// Once the context is done, it stops and returns the context error.
+func (c *CopyOnWriteList[T]) ForEachContext(ctx context.Context, handler Consumer[T]) error {
+	return c.view().ForEachContext(ctx, handler)
+}
+
+// Pop removes the last element from the IList and returns itself.
+// If CopyOnWriteList is empty (see IsEmpty), panics.
+func (c *CopyOnWriteList[T]) Pop() IList[T] {
+	return must(c.TryPop())
+}
+
+// TryPop removes the last element from the IList and returns itself.
+// If CopyOnWriteList is empty (see IsEmpty), it is kept unaltered and ErrEmpty is returned.
+func (c *CopyOnWriteList[T]) TryPop() (IList[T], error) {
+	return c, c.write(func(l *List[T]) error {
+		_, err := l.TryPop()
+		return err
+	})
+}
+
+// Shift removes the first element from the IList and then returns itself.
+// If CopyOnWriteList is empty (see IsEmpty), panics.
+func (c *CopyOnWriteList[T]) Shift() IList[T] {
+	return must(c.TryShift())
+}
+
+// TryShift removes the first element from the IList and then returns itself.
+// If CopyOnWriteList is empty (see IsEmpty), it is kept unaltered and ErrEmpty is returned.
+func (c *CopyOnWriteList[T]) TryShift() (IList[T], error) {
+	return c, c.write(func(l *List[T]) error {
+		_, err := l.TryShift()
+		return err
+	})
+}
+
+// Set sets the given element at the given index, and then returns itself.
+// If there is no element at the given index, panics.
+func (c *CopyOnWriteList[T]) Set(index int, element T) IList[T] {
+	return must(c.TrySet(index, element))
+}
+
+// TrySet sets the given element at the given index, and then returns itself.
+// If there is no element at the given index, it is kept unaltered and ErrIndexOutOfRange is returned.
+func (c *CopyOnWriteList[T]) TrySet(index int, element T) (IList[T], error) {
+	return c, c.write(func(l *List[T]) error {
+		_, err := l.TrySet(index, element)
+		return err
+	})
+}
+
+// InsertAt inserts the given elements at the given index, moving the following elements forward, and then returns itself.
+// The index may be equal to Length, to insert at the end. Otherwise, if there is no element at the given index, panics.
+func (c *CopyOnWriteList[T]) InsertAt(index int, elements ...T) IList[T] {
+	c.write(func(l *List[T]) error {
+		l.InsertAt(index, elements...)
+		return nil
+	})
+	return c
+}
+
+// RemoveAt removes the element at the given index, moving the following elements backward, and then returns itself.
+// If there is no element at the given index, panics.
+func (c *CopyOnWriteList[T]) RemoveAt(index int) IList[T] {
+	return c.RemoveRange(index, index)
+}
+
+// RemoveRange removes all elements between the *from* and *to* indexes, and then returns itself.
+// If the interval is not within the CopyOnWriteList bounds, panics.
+func (c *CopyOnWriteList[T]) RemoveRange(from, to int) IList[T] {
+	c.write(func(l *List[T]) error {
+		l.RemoveRange(from, to)
+		return nil
+	})
+	return c
+}
+
+// Splice removes deleteCount elements starting at the *start* index, inserts the given elements in their place,
+// and returns a new List with the removed elements. deleteCount is limited to the elements available after start.
+// The start index may be equal to Length, to insert at the end. Otherwise, if there is no element at the given index, panics.
+func (c *CopyOnWriteList[T]) Splice(start, deleteCount int, elements ...T) (removed IList[T]) {
+	c.write(func(l *List[T]) error {
+		removed = l.Splice(start, deleteCount, elements...)
+		return nil
+	})
+	return
+}
+
+// RemoveWhere removes all the elements which satisfies the predicate, and then returns itself.
+func (c *CopyOnWriteList[T]) RemoveWhere(handler Predicate[T]) IList[T] {
+	c.write(func(l *List[T]) error {
+		l.RemoveWhere(handler)
+		return nil
+	})
+	return c
+}
+
+// Interval returns a new List with all elements between the *from* and *to* indexes.
+// If the interval is not within the CopyOnWriteList bounds, panics.
+func (c *CopyOnWriteList[T]) Interval(from, to int) IList[T] {
+	return c.view().Interval(from, to)
+}
+
+// TryInterval returns a new List with all elements between the *from* and *to* indexes.
+// If the interval is not within the CopyOnWriteList bounds, ErrIndexOutOfRange is returned.
+func (c *CopyOnWriteList[T]) TryInterval(from, to int) (IList[T], error) {
+	return c.view().TryInterval(from, to)
+}
+
+// Slice returns a new List with the elements from the *from* index (inclusive) to the *to* index (exclusive), taking every *step* element.
+// Negative indexes are counted from the end of the CopyOnWriteList (-1 is the last element), and out-of-range bounds are clamped.
+// A negative step walks backwards, from *from* down to *to*. A zero step panics with ErrZeroStep.
+func (c *CopyOnWriteList[T]) Slice(from, to, step int) IList[T] {
+	return c.view().Slice(from, to, step)
+}
+
+// String returns a string representation of the CopyOnWriteList.
+func (c *CopyOnWriteList[T]) String() string {
+	return c.view().String()
+}
+
+// Join returns the string representation of each element in the IList, separated by the given separator
+func (c *CopyOnWriteList[T]) Join(separator string) string {
+	return c.view().Join(separator)
+}
+
+// Sort receives a Sorter function to sort its elements, and returns itself after sorted.
+// Sort is stable: elements considered equal by the Sorter keep their original order.
+func (c *CopyOnWriteList[T]) Sort(sorter Sorter[T]) IList[T] {
+	c.write(func(l *List[T]) error {
+		l.Sort(sorter)
+		return nil
+	})
+	return c
+}
+
+// Clear removes all elements from the CopyOnWriteList, making it empty, and then returns itself.
+func (c *CopyOnWriteList[T]) Clear() IList[T] {
+	c.write(func(l *List[T]) error {
+		l.Clear()
+		return nil
+	})
+	return c
+}
+
+// IsDynamicallySized returns true, as CopyOnWriteList is a dynamically-sized implementation of IList
+func (c *CopyOnWriteList[T]) IsDynamicallySized() bool {
+	return true
+}
+
+// IsThreadSafe returns true, as CopyOnWriteList is a thread-safe implementation of IList
+func (c *CopyOnWriteList[T]) IsThreadSafe() bool {
+	return true
+}
+
+func (c *CopyOnWriteList[T]) UnmarshalJSON(data []byte) error {
+	var elements List[T]
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return err
+	}
+	return c.write(func(l *List[T]) error {
+		*l = elements
+		return nil
+	})
+}
+
+func (c *CopyOnWriteList[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.view())
+}
@@ -0,0 +1,63 @@
+package lists
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestCopyOnWriteList_NoAliasing(t *testing.T) {
+	list := NewCopyOnWriteList(1, 2, 3)
+	elements := list.Elements()
+	elements[0] = 10
+	*list.At(1) = 20
+	*list.First() = 30
+	if list.Join(",") != "1,2,3" {
+		t.Errorf("CopyOnWriteList should not be changed through Elements or At. Got: %v", list)
+	}
+	before := list.Elements()
+	list.Set(0, 4).Push(5)
+	if fmtInts(before) != "1,2,3" || list.Join(",") != "4,2,3,5" {
+		t.Errorf("CopyOnWriteList writes should not change previous reads. Got: %v and %v", before, list)
+	}
+}
+
+func TestCopyOnWriteList_Clone(t *testing.T) {
+	list := NewCopyOnWriteList(3, 1, 2)
+	cloned := list.Clone()
+	cloned.Sort(func(a, b int) int {
+		return a - b
+	}).Pop()
+	list.Shift()
+	if list.Join(",") != "1,2" || cloned.Join(",") != "1,2" || !cloned.IsThreadSafe() {
+		t.Errorf("CopyOnWriteList clones should be independent. Got: %v and %v", list, cloned)
+	}
+	if list.Clear().IsNotEmpty() || cloned.IsEmpty() {
+		t.Error("CopyOnWriteList clones should be independent")
+	}
+}
+
+func TestCopyOnWriteList_Concurrency(t *testing.T) {
+	list := NewCopyOnWriteList[int]()
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(2)
+		go func(i int) {
+			list.Push(i)
+			wg.Done()
+		}(i)
+		go func() {
+			list.Every(func(v int) bool {
+				return v >= 0
+			})
+			wg.Done()
+		}()
+	}
+	wg.Wait()
+	if list.Length() != 50 {
+		t.Errorf("CopyOnWriteList should not lose writes. Got: %v elements", list.Length())
+	}
+}
+
+func fmtInts(elements []int) string {
+	return NewList(elements...).Join(",")
+}
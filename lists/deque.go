@@ -0,0 +1,308 @@
+package lists
+
+import (
+	"fmt"
+	"sync"
+)
+
+// minDequeCapacity is the smallest capacity of a non-empty Deque ring buffer. Deques never shrink below it.
+const minDequeCapacity = 8
+
+// deque is implemented by Deque and SafeDeque, so Stack and Queue may be built on either.
+type deque[T any] interface {
+	PushBack(elements ...T)
+	PushFront(elements ...T)
+	PopFront() (T, bool)
+	PopBack() (T, bool)
+	PeekFront() (T, bool)
+	PeekBack() (T, bool)
+	Length() int
+	Elements() []T
+	Clear()
+	IsThreadSafe() bool
+}
+
+// NewDeque returns a new Deque with the given elements, from front to back
+func NewDeque[T any](elements ...T) *Deque[T] {
+	d := &Deque[T]{}
+	d.PushBack(elements...)
+	return d
+}
+
+// NewDequeFrom returns a new Deque with the given slice, from front to back
+func NewDequeFrom[T any](elements []T) *Deque[T] {
+	return NewDeque(elements...)
+}
+
+// Deque is a double-ended queue, backed by a ring buffer, and thread-unsafe.
+// Adding and removing elements at both ends runs in amortized O(1). The ring buffer grows when full,
+// and shrinks when mostly empty, so removed elements are released and a long-running Deque does not leak memory.
+// The zero value is an empty Deque.
+type Deque[T any] struct {
+	buf    []T
+	head   int
+	length int
+}
+
+// Length returns how many elements are in the Deque.
+func (d *Deque[T]) Length() int {
+	return d.length
+}
+
+// IsEmpty returns true if there are *no* elements stored in the Deque.
+func (d *Deque[T]) IsEmpty() bool {
+	return d.length == 0
+}
+
+// IsNotEmpty returns true if there are elements stored in the Deque.
+func (d *Deque[T]) IsNotEmpty() bool {
+	return d.length > 0
+}
+
+// At returns the element at the given index, counted from the front of the Deque, and true.
+// If there is no element at the given index, the zero value and false are returned.
+func (d *Deque[T]) At(i int) (T, bool) {
+	if i < 0 || i >= d.length {
+		var zero T
+		return zero, false
+	}
+	return d.buf[d.index(i)], true
+}
+
+// PushBack adds the given elements at the back of the Deque, in order.
+func (d *Deque[T]) PushBack(elements ...T) {
+	for _, v := range elements {
+		if d.length == len(d.buf) {
+			d.resize(2 * len(d.buf))
+		}
+		d.buf[d.index(d.length)] = v
+		d.length++
+	}
+}
+
+// PushFront adds the given elements at the front of the Deque, keeping their order: PushFront(1, 2) leaves 1 at the front.
+func (d *Deque[T]) PushFront(elements ...T) {
+	for i := len(elements) - 1; i >= 0; i-- {
+		if d.length == len(d.buf) {
+			d.resize(2 * len(d.buf))
+		}
+		d.head = d.index(len(d.buf) - 1)
+		d.buf[d.head] = elements[i]
+		d.length++
+	}
+}
+
+// PopFront removes the element at the front of the Deque, and returns it and true.
+// If the Deque is empty, the zero value and false are returned.
+func (d *Deque[T]) PopFront() (T, bool) {
+	v, ok := d.PeekFront()
+	if ok {
+		d.remove(d.head)
+		d.head = d.index(1)
+		d.length--
+		d.shrink()
+	}
+	return v, ok
+}
+
+// PopBack removes the element at the back of the Deque, and returns it and true.
+// If the Deque is empty, the zero value and false are returned.
+func (d *Deque[T]) PopBack() (T, bool) {
+	v, ok := d.PeekBack()
+	if ok {
+		d.remove(d.index(d.length - 1))
+		d.length--
+		d.shrink()
+	}
+	return v, ok
+}
+
+// PeekFront returns the element at the front of the Deque and true, without removing it.
+// If the Deque is empty, the zero value and false are returned.
+func (d *Deque[T]) PeekFront() (T, bool) {
+	return d.At(0)
+}
+
+// PeekBack returns the element at the back of the Deque and true, without removing it.
+// If the Deque is empty, the zero value and false are returned.
+func (d *Deque[T]) PeekBack() (T, bool) {
+	return d.At(d.length - 1)
+}
+
+// Elements returns a new built-in slice with all elements in the Deque, from front to back.
+func (d *Deque[T]) Elements() []T {
+	elements := make([]T, d.length)
+	if d.length > 0 {
+		n := copy(elements, d.buf[d.head:])
+		copy(elements[n:], d.buf[:d.length-n])
+	}
+	return elements
+}
+
+// Clear removes all elements from the Deque, making it empty, and releases its ring buffer.
+func (d *Deque[T]) Clear() {
+	d.buf, d.head, d.length = nil, 0, 0
+}
+
+// ToList returns a new List with the elements of the Deque, from front to back.
+func (d *Deque[T]) ToList() IList[T] {
+	return NewListFrom(d.Elements())
+}
+
+// String returns a string representation of the Deque, from front to back.
+func (d *Deque[T]) String() string {
+	return fmt.Sprint(d.Elements())
+}
+
+// IsThreadSafe returns false, as Deque is not thread-safe
+func (d *Deque[T]) IsThreadSafe() bool {
+	return false
+}
+
+// index returns the ring buffer index of the element at the given offset from the front.
+func (d *Deque[T]) index(offset int) int {
+	return (d.head + offset) % len(d.buf)
+}
+
+// remove zeroes the given ring buffer slot, so its element may be garbage collected.
+func (d *Deque[T]) remove(slot int) {
+	var zero T
+	d.buf[slot] = zero
+}
+
+// shrink halves the ring buffer when it is at most a quarter full.
+func (d *Deque[T]) shrink() {
+	if len(d.buf) > minDequeCapacity && d.length <= len(d.buf)/4 {
+		d.resize(len(d.buf) / 2)
+	}
+}
+
+// resize moves the elements to a new ring buffer with the given capacity, starting at its beginning.
+func (d *Deque[T]) resize(capacity int) {
+	if capacity < minDequeCapacity {
+		capacity = minDequeCapacity
+	}
+	buf := make([]T, capacity)
+	if d.length > 0 {
+		n := copy(buf[:d.length], d.buf[d.head:])
+		copy(buf[n:d.length], d.buf[:d.length-n])
+	}
+	d.buf, d.head = buf, 0
+}
+
+// NewSafeDeque returns a new SafeDeque with the given elements, from front to back
+func NewSafeDeque[T any](elements ...T) *SafeDeque[T] {
+	return &SafeDeque[T]{d: NewDeque(elements...)}
+}
+
+// NewSafeDequeFrom returns a new SafeDeque with the given slice, from front to back
+func NewSafeDequeFrom[T any](elements []T) *SafeDeque[T] {
+	return NewSafeDeque(elements...)
+}
+
+// SafeDeque is the thread-safe variant of Deque.
+// Read-only methods share a read lock, so they may run concurrently. Methods which change the SafeDeque take an exclusive lock.
+type SafeDeque[T any] struct {
+	d *Deque[T]
+	sync.RWMutex
+}
+
+// Length returns how many elements are in the SafeDeque.
+func (s *SafeDeque[T]) Length() int {
+	s.RLock()
+	defer s.RUnlock()
+	return s.d.Length()
+}
+
+// IsEmpty returns true if there are *no* elements stored in the SafeDeque.
+func (s *SafeDeque[T]) IsEmpty() bool {
+	return s.Length() == 0
+}
+
+// IsNotEmpty returns true if there are elements stored in the SafeDeque.
+func (s *SafeDeque[T]) IsNotEmpty() bool {
+	return s.Length() > 0
+}
+
+// At returns the element at the given index, counted from the front of the SafeDeque, and true.
+// If there is no element at the given index, the zero value and false are returned.
+func (s *SafeDeque[T]) At(i int) (T, bool) {
+	s.RLock()
+	defer s.RUnlock()
+	return s.d.At(i)
+}
+
+// PushBack adds the given elements at the back of the SafeDeque, in order.
+func (s *SafeDeque[T]) PushBack(elements ...T) {
+	s.Lock()
+	defer s.Unlock()
+	s.d.PushBack(elements...)
+}
+
+// PushFront adds the given elements at the front of the SafeDeque, keeping their order: PushFront(1, 2) leaves 1 at the front.
+func (s *SafeDeque[T]) PushFront(elements ...T) {
+	s.Lock()
+	defer s.Unlock()
+	s.d.PushFront(elements...)
+}
+
+// PopFront removes the element at the front of the SafeDeque, and returns it and true.
+// If the SafeDeque is empty, the zero value and false are returned.
+func (s *SafeDeque[T]) PopFront() (T, bool) {
+	s.Lock()
+	defer s.Unlock()
+	return s.d.PopFront()
+}
+
+// PopBack removes the element at the back of the SafeDeque, and returns it and true.
+// If the SafeDeque is empty, the zero value and false are returned.
+func (s *SafeDeque[T]) PopBack() (T, bool) {
+	s.Lock()
+	defer s.Unlock()
+	return s.d.PopBack()
+}
+
+// PeekFront returns the element at the front of the SafeDeque and true, without removing it.
+// If the SafeDeque is empty, the zero value and false are returned.
+func (s *SafeDeque[T]) PeekFront() (T, bool) {
+	s.RLock()
+	defer s.RUnlock()
+	return s.d.PeekFront()
+}
+
+// PeekBack returns the element at the back of the SafeDeque and true, without removing it.
+// If the SafeDeque is empty, the zero value and false are returned.
+func (s *SafeDeque[T]) PeekBack() (T, bool) {
+	s.RLock()
+	defer s.RUnlock()
+	return s.d.PeekBack()
+}
+
+// Elements returns a new built-in slice with all elements in the SafeDeque, from front to back.
+func (s *SafeDeque[T]) Elements() []T {
+	s.RLock()
+	defer s.RUnlock()
+	return s.d.Elements()
+}
+
+// Clear removes all elements from the SafeDeque, making it empty, and releases its ring buffer.
+func (s *SafeDeque[T]) Clear() {
+	s.Lock()
+	defer s.Unlock()
+	s.d.Clear()
+}
+
+// ToList returns a new SafeList with the elements of the SafeDeque, from front to back.
+func (s *SafeDeque[T]) ToList() IList[T] {
+	return NewSafeListFrom(s.Elements())
+}
+
+// String returns a string representation of the SafeDeque, from front to back.
+func (s *SafeDeque[T]) String() string {
+	return fmt.Sprint(s.Elements())
+}
+
+// IsThreadSafe returns true, as SafeDeque is thread-safe
+func (s *SafeDeque[T]) IsThreadSafe() bool {
+	return true
+}
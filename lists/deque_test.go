@@ -0,0 +1,107 @@
+package lists
+
+import (
+	"math/rand"
+	"sync"
+	"testing"
+)
+
+func TestDeque(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	var d Deque[int]
+	model := NewList[int]()
+	for step := 0; step < 10000; step++ {
+		switch random.Intn(4) {
+		case 0:
+			d.PushBack(step, -step)
+			model.Push(step, -step)
+		case 1:
+			d.PushFront(step, -step)
+			model.InsertAt(0, step, -step)
+		case 2:
+			v, ok := d.PopFront()
+			if ok != model.IsNotEmpty() || (ok && v != model.FirstElement()) {
+				t.Fatalf("PopFront at step %v: expected %v. Got: %v, %v", step, model.First(), v, ok)
+			}
+			model.TryShift()
+		case 3:
+			v, ok := d.PopBack()
+			if ok != model.IsNotEmpty() || (ok && v != model.LastElement()) {
+				t.Fatalf("PopBack at step %v: expected %v. Got: %v, %v", step, model.Last(), v, ok)
+			}
+			model.TryPop()
+		}
+		if d.Length() != model.Length() {
+			t.Fatalf("Deque diverged at step %v. Expected %v. Got: %v", step, model, d.String())
+		}
+	}
+	if d.ToList().String() != model.String() {
+		t.Errorf("Deque diverged. Expected %v. Got: %v", model, d.String())
+	}
+}
+
+func TestDeque_Ends(t *testing.T) {
+	d := NewDeque(2, 3)
+	d.PushFront(0, 1)
+	d.PushBack(4)
+	front, _ := d.PeekFront()
+	back, _ := d.PeekBack()
+	at, ok := d.At(2)
+	if d.String() != "[0 1 2 3 4]" || front != 0 || back != 4 || at != 2 || !ok {
+		t.Errorf("Deque should keep its elements in order. Got: %v", d)
+	}
+	if _, ok := d.At(5); ok {
+		t.Error("At should be false out of range")
+	}
+	d.Clear()
+	if v, ok := d.PopFront(); ok || v != 0 || d.IsNotEmpty() {
+		t.Errorf("PopFront should be false when empty. Got: %v, %v", v, ok)
+	}
+	if _, ok := d.PeekBack(); ok {
+		t.Error("PeekBack should be false when empty")
+	}
+}
+
+func TestDeque_Shrink(t *testing.T) {
+	d := NewDeque[*int]()
+	for i := 0; i < 1000; i++ {
+		d.PushBack(new(int))
+	}
+	for i := 0; i < 995; i++ {
+		d.PopFront()
+	}
+	if len(d.buf) > 4*minDequeCapacity {
+		t.Errorf("Deque should shrink its ring buffer. Got capacity %v", len(d.buf))
+	}
+	for i, v := range d.buf {
+		if v != nil && (i < d.head || i >= d.head+d.length) {
+			t.Errorf("Deque should release removed elements. Got one at %v", i)
+		}
+	}
+}
+
+func TestSafeDeque(t *testing.T) {
+	d := NewSafeDeque[int]()
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				if i%2 == 0 {
+					d.PushBack(j)
+				} else {
+					d.PushFront(j)
+				}
+				d.PeekFront()
+			}
+		}(i)
+	}
+	wg.Wait()
+	if d.Length() != 800 || d.ToList().Length() != 800 || !d.ToList().IsThreadSafe() {
+		t.Errorf("SafeDeque should keep every pushed element. Got: %v", d.Length())
+	}
+	for d.IsNotEmpty() {
+		d.PopBack()
+	}
+}
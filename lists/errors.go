@@ -0,0 +1,54 @@
+package lists
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrIndexOutOfRange is returned when an index (or interval) is not within the bounds of an IList.
+	ErrIndexOutOfRange = errors.New("lists: index out of range")
+
+	// ErrEmpty is returned when an element is required from an empty IList.
+	ErrEmpty = errors.New("lists: empty list")
+
+	// ErrNotFound is returned when no element satisfies a predicate.
+	ErrNotFound = errors.New("lists: no element satisfies the predicate")
+
+	// ErrZeroStep is returned when an IList is sliced with a zero step.
+	ErrZeroStep = errors.New("lists: slice step cannot be zero")
+
+	// ErrInvalidSize is returned when a chunk, window or batch size (or step), or a capacity, is not greater than zero.
+	ErrInvalidSize = errors.New("lists: size must be greater than zero")
+
+	// ErrCapacityExceeded is returned when elements are added to a fixed-capacity IList with no room left for them.
+	ErrCapacityExceeded = errors.New("lists: capacity exceeded")
+
+	// ErrUnordered is returned when a change would break the order of a SortedList.
+	ErrUnordered = errors.New("lists: elements would be out of order")
+
+	// ErrClosed is returned when a BlockingQueue is used after being closed.
+	ErrClosed = errors.New("lists: queue closed")
+
+	// ErrOverflow is returned when an integer aggregate does not fit in its type.
+	ErrOverflow = errors.New("lists: integer overflow")
+
+	// ErrInvalidPercentile is returned when a percentile is not between 0 and 100.
+	ErrInvalidPercentile = errors.New("lists: percentile must be between 0 and 100")
+
+	// ErrNotObservable is returned when an ObservableList would wrap an IList whose changes it can not describe.
+	ErrNotObservable = errors.New("lists: list changes can not be observed")
+)
+
+// outOfRange returns an ErrIndexOutOfRange describing the given index and length.
+func outOfRange(index, length int) error {
+	return fmt.Errorf("%w: index %d with length %d", ErrIndexOutOfRange, index, length)
+}
+
+// must returns the given value, or panics with the given error, if any.
+func must[T any](value T, err error) T {
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
@@ -0,0 +1,483 @@
+package lists
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// OverflowPolicy defines how a FixedList behaves when Push exceeds its capacity.
+type OverflowPolicy int
+
+const (
+	// OverflowPanic makes Push panic with ErrCapacityExceeded, keeping the FixedList unaltered.
+	// It is the default OverflowPolicy.
+	OverflowPanic OverflowPolicy = iota
+
+	// OverflowDiscard makes Push store only the elements which fit in the FixedList, discarding the remaining ones.
+	OverflowDiscard
+
+	// OverflowEvict makes Push remove the oldest elements from the FixedList to make room for the new ones.
+	OverflowEvict
+)
+
+// NewFixedList returns a new FixedList with the given capacity and elements
+// If capacity is not greater than zero, panics with ErrInvalidSize.
+func NewFixedList[T any](capacity int, elements ...T) *FixedList[T] {
+	if capacity <= 0 {
+		panic(ErrInvalidSize)
+	}
+	l := List[T](make([]T, 0, capacity))
+	f := &FixedList[T]{l: &l, capacity: capacity}
+	f.Push(elements...)
+	return f
+}
+
+// NewFixedListFrom returns a new FixedList with the given capacity and slice
+func NewFixedListFrom[T any](capacity int, elements []T) *FixedList[T] {
+	return NewFixedList(capacity, elements...)
+}
+
+// FixedList is a fixed-capacity and thread-unsafe implementation of IList.
+// Its backing array is allocated once, at construction, and is never grown, so it must be created with NewFixedList:
+// its zero value has no backing array, and is not usable.
+// Adding elements beyond its capacity is handled by its OverflowPolicy (see OnOverflow), or may be checked with TryPush.
+// Package functions which build a new IList like the given one (such as Union or Partition) return a List for a FixedList,
+// and a SafeList for a SafeFixedList, so their results are never cut by its capacity.
+type FixedList[T any] struct {
+	l        *List[T]
+	capacity int
+	overflow OverflowPolicy
+}
+
+// Capacity returns how many elements the FixedList is able to store.
+func (f *FixedList[T]) Capacity() int {
+	return f.capacity
+}
+
+// Remaining returns how many elements may still be pushed before the FixedList is full.
+func (f *FixedList[T]) Remaining() int {
+	return f.capacity - f.Length()
+}
+
+// IsFull returns true if the FixedList has no room left for new elements.
+func (f *FixedList[T]) IsFull() bool {
+	return f.Remaining() == 0
+}
+
+// OnOverflow sets the OverflowPolicy used by Push when the FixedList capacity is exceeded, and then returns itself.
+func (f *FixedList[T]) OnOverflow(policy OverflowPolicy) *FixedList[T] {
+	f.overflow = policy
+	return f
+}
+
+// TryPush add the given elements in the FixedList if all of them fit in it.
+// Otherwise, the FixedList is kept unaltered and ErrCapacityExceeded is returned, regardless of the OverflowPolicy.
+func (f *FixedList[T]) TryPush(elements ...T) error {
+	if len(elements) > f.Remaining() {
+		return ErrCapacityExceeded
+	}
+	f.l.Push(elements...)
+	return nil
+}
+
+// Length returns how many elements are in the FixedList.
+func (f *FixedList[T]) Length() int {
+	return f.l.Length()
+}
+
+// IsEmpty returns true if there are *no* Elements stored in the FixedList.
+func (f *FixedList[T]) IsEmpty() bool {
+	return f.l.IsEmpty()
+}
+
+// IsNotEmpty returns true if there are Elements stored in the FixedList.
+func (f *FixedList[T]) IsNotEmpty() bool {
+	return f.l.IsNotEmpty()
+}
+
+// At returns the pointer of the element at the given index from the FixedList.
+// If there is no element at the given index, nil will be returned.
+func (f *FixedList[T]) At(i int) *T {
+	return f.l.At(i)
+}
+
+// AtFromEnd returns the pointer of the element at the given offset from the end of the FixedList: AtFromEnd(0) is the last element.
+// If there is no element at the given offset, nil will be returned.
+func (f *FixedList[T]) AtFromEnd(i int) *T {
+	return f.l.AtFromEnd(i)
+}
+
+// ElementAt returns the element at the given index from the FixedList.
+// If there is no element at the given index, panics.
+func (f *FixedList[T]) ElementAt(i int) T {
+	return f.l.ElementAt(i)
+}
+
+// TryElementAt returns the element at the given index from the FixedList.
+// If there is no element at the given index, ErrIndexOutOfRange is returned.
+func (f *FixedList[T]) TryElementAt(i int) (T, error) {
+	return f.l.TryElementAt(i)
+}
+
+// Elements returns a built-in slice with all elements in the FixedList.
+func (f *FixedList[T]) Elements() []T {
+	return f.l.Elements()
+}
+
+// Push add the given elements in the FixedList, and then returns itself.
+// If the elements do not fit in the FixedList, the OverflowPolicy is applied.
+func (f *FixedList[T]) Push(elements ...T) IList[T] {
+	if f.TryPush(elements...) == nil {
+		return f
+	}
+	switch f.overflow {
+	case OverflowDiscard:
+		f.l.Push(elements[:f.Remaining()]...)
+	case OverflowEvict:
+		f.evict(len(elements) - f.Remaining())
+		if len(elements) > f.capacity {
+			elements = elements[len(elements)-f.capacity:]
+		}
+		f.l.Push(elements...)
+	default:
+		panic(ErrCapacityExceeded)
+	}
+	return f
+}
+
+// Clone returns an identical FixedList from the original, with the same capacity and OverflowPolicy.
+func (f *FixedList[T]) Clone() IList[T] {
+	return NewFixedList(f.capacity, f.Elements()...).OnOverflow(f.overflow)
+}
+
+// FirstElement returns the first element in the FixedList.
+// If FixedList is empty (see IsEmpty), panics
+func (f *FixedList[T]) FirstElement() T {
+	return f.l.FirstElement()
+}
+
+// TryFirstElement returns the first element in the FixedList.
+// If FixedList is empty (see IsEmpty), ErrEmpty is returned.
+func (f *FixedList[T]) TryFirstElement() (T, error) {
+	return f.l.TryFirstElement()
+}
+
+// First returns the pointer of the first element in the FixedList.
+// If FixedList is empty (see IsEmpty), nil will be returned.
+func (f *FixedList[T]) First() *T {
+	return f.l.First()
+}
+
+// LastElement returns the last element in the FixedList.
+// If FixedList is empty (see IsEmpty), panics.
+func (f *FixedList[T]) LastElement() T {
+	return f.l.LastElement()
+}
+
+// TryLastElement returns the last element in the FixedList.
+// If FixedList is empty (see IsEmpty), ErrEmpty is returned.
+func (f *FixedList[T]) TryLastElement() (T, error) {
+	return f.l.TryLastElement()
+}
+
+// Last returns the pointer of the last element in the FixedList.
+// If FixedList is empty (see IsEmpty), nil will be returned.
+func (f *FixedList[T]) Last() *T {
+	return f.l.Last()
+}
+
+// FirstIndexWhere returns the index of the first element which satisfies the predicate.
+// If no element satisfies the predicate, -1 will be returned.
+func (f *FixedList[T]) FirstIndexWhere(handler Predicate[T]) int {
+	return f.l.FirstIndexWhere(handler)
+}
+
+// FirstWhere returns the pointer of the first element which satisfies the predicate.
+// If no element satisfies the predicate, nil will be returned.
+func (f *FixedList[T]) FirstWhere(handler Predicate[T]) *T {
+	return f.l.FirstWhere(handler)
+}
+
+// FirstElementWhere returns the first element which satisfies the predicate.
+// If no element satisfies the predicate, panics.
+func (f *FixedList[T]) FirstElementWhere(handler Predicate[T]) T {
+	return f.l.FirstElementWhere(handler)
+}
+
+// TryFirstElementWhere returns the first element which satisfies the predicate.
+// If no element satisfies the predicate, ErrNotFound is returned.
+func (f *FixedList[T]) TryFirstElementWhere(handler Predicate[T]) (T, error) {
+	return f.l.TryFirstElementWhere(handler)
+}
+
+// LastIndexWhere returns the index of the last element which satisfies the predicate.
+// If no element satisfies the predicate, -1 will be returned.
+func (f *FixedList[T]) LastIndexWhere(handler Predicate[T]) int {
+	return f.l.LastIndexWhere(handler)
+}
+
+// LastWhere returns the pointer of the last element which satisfies the predicate.
+// If no element satisfies the predicate, nil will be returned.
+func (f *FixedList[T]) LastWhere(handler Predicate[T]) *T {
+	return f.l.LastWhere(handler)
+}
+
+// LastElementWhere returns the last element which satisfies the predicate.
+// If no element satisfies the predicate, panics.
+func (f *FixedList[T]) LastElementWhere(handler Predicate[T]) T {
+	return f.l.LastElementWhere(handler)
+}
+
+// TryLastElementWhere returns the last element which satisfies the predicate.
+// If no element satisfies the predicate, ErrNotFound is returned.
+func (f *FixedList[T]) TryLastElementWhere(handler Predicate[T]) (T, error) {
+	return f.l.TryLastElementWhere(handler)
+}
+
+// IndexWhere returns a List[int] for all element index which satisfies the predicate.
+// If no element satisfies the predicate, an empty List will be returned.
+func (f *FixedList[T]) IndexWhere(handler Predicate[T]) IList[int] {
+	return f.l.IndexWhere(handler)
+}
+
+// Where returns a List with all the elements which satisfies the predicate.
+// If no element satisfies the predicate, an empty List will be returned.
+func (f *FixedList[T]) Where(handler Predicate[T]) IList[T] {
+	return f.l.Where(handler)
+}
+
+// Map iterates over the element of the FixedList calling Mapper, and return a new List with the results.
+func (f *FixedList[T]) Map(handler Mapper[T]) IList[any] {
+	return f.l.Map(handler)
+}
+
+// Reduce executes the Reducer for each element from the list with the given accumulator, and each result will be the accumulator for the next.
+// The final result will be returned.
+func (f *FixedList[T]) Reduce(reducer Reducer[T], accumulator any) any {
+	return f.l.Reduce(reducer, accumulator)
+}
+
+// Every returns true if every element in the IList satisfies the predicate.
+func (f *FixedList[T]) Every(handler Predicate[T]) bool {
+	return f.l.Every(handler)
+}
+
+// Some returns true if at least one element in the IList satisfies the predicate.
+func (f *FixedList[T]) Some(handler Predicate[T]) bool {
+	return f.l.Some(handler)
+}
+
+// None returns true no element in the IList satisfy the predicate.
+func (f *FixedList[T]) None(handler Predicate[T]) bool {
+	return f.l.None(handler)
+}
+
+// ForEach calls the Consumer with each element of the IList and its index, in order.
+func (f *FixedList[T]) ForEach(handler Consumer[T]) {
+	f.l.ForEach(handler)
+}
+
+// ForEachUntil calls the IndexedPredicate with each element of the IList and its index, in order, until it returns true.
+func (f *FixedList[T]) ForEachUntil(handler IndexedPredicate[T]) {
+	f.l.ForEachUntil(handler)
+}
+
+// ForEachContext calls the Consumer with each element of the IList and its index, in order, checking the context before each call.
+// Once the context is done, it stops and returns the context error.
+func (f *FixedList[T]) ForEachContext(ctx context.Context, handler Consumer[T]) error {
+	return f.l.ForEachContext(ctx, handler)
+}
+
+// Pop removes the last element from the IList and returns itself.
+// If FixedList is empty (see IsEmpty), panics.
+func (f *FixedList[T]) Pop() IList[T] {
+	return must(f.TryPop())
+}
+
+// TryPop removes the last element from the IList and returns itself.
+// If FixedList is empty (see IsEmpty), it is kept unaltered and ErrEmpty is returned.
+func (f *FixedList[T]) TryPop() (IList[T], error) {
+	_, err := f.l.TryPop()
+	return f, err
+}
+
+// Shift removes the first element from the IList and then returns itself.
+// Remaining elements are moved to the beginning of the backing array, so the capacity is never lost.
+// If FixedList is empty (see IsEmpty), panics.
+func (f *FixedList[T]) Shift() IList[T] {
+	return must(f.TryShift())
+}
+
+// TryShift removes the first element from the IList and then returns itself.
+// If FixedList is empty (see IsEmpty), it is kept unaltered and ErrEmpty is returned.
+func (f *FixedList[T]) TryShift() (IList[T], error) {
+	if f.IsEmpty() {
+		return f, ErrEmpty
+	}
+	f.evict(1)
+	return f, nil
+}
+
+// Set sets the given element at the given index, and then returns itself.
+// If there is no element at the given index, panics.
+func (f *FixedList[T]) Set(index int, element T) IList[T] {
+	return must(f.TrySet(index, element))
+}
+
+// TrySet sets the given element at the given index, and then returns itself.
+// If there is no element at the given index, it is kept unaltered and ErrIndexOutOfRange is returned.
+func (f *FixedList[T]) TrySet(index int, element T) (IList[T], error) {
+	_, err := f.l.TrySet(index, element)
+	return f, err
+}
+
+// InsertAt inserts the given elements at the given index, moving the following elements forward, and then returns itself.
+// The index may be equal to Length, to insert at the end. Otherwise, if there is no element at the given index, panics.
+// If the elements do not fit in the FixedList, panics with ErrCapacityExceeded, regardless of the OverflowPolicy.
+func (f *FixedList[T]) InsertAt(index int, elements ...T) IList[T] {
+	if len(elements) > f.Remaining() {
+		panic(ErrCapacityExceeded)
+	}
+	f.l.InsertAt(index, elements...)
+	return f
+}
+
+// RemoveAt removes the element at the given index, moving the following elements backward, and then returns itself.
+// If there is no element at the given index, panics.
+func (f *FixedList[T]) RemoveAt(index int) IList[T] {
+	f.l.RemoveAt(index)
+	return f
+}
+
+// RemoveRange removes all elements between the *from* and *to* indexes, and then returns itself.
+// If the interval is not within the FixedList bounds, panics.
+func (f *FixedList[T]) RemoveRange(from, to int) IList[T] {
+	f.l.RemoveRange(from, to)
+	return f
+}
+
+// Splice removes deleteCount elements starting at the *start* index, inserts the given elements in their place,
+// and returns a new List with the removed elements. deleteCount is limited to the elements available after start.
+// The start index may be equal to Length, to insert at the end. Otherwise, if there is no element at the given index, panics.
+// If the resulting elements do not fit in the FixedList, panics with ErrCapacityExceeded, regardless of the OverflowPolicy.
+func (f *FixedList[T]) Splice(start, deleteCount int, elements ...T) IList[T] {
+	if start < 0 || start > f.Length() {
+		panic(outOfRange(start, f.Length()))
+	}
+	available := f.Length() - start
+	if deleteCount > available {
+		deleteCount = available
+	}
+	if deleteCount < 0 {
+		deleteCount = 0
+	}
+	if len(elements)-deleteCount > f.Remaining() {
+		panic(ErrCapacityExceeded)
+	}
+	return f.l.Splice(start, deleteCount, elements...)
+}
+
+// RemoveWhere removes all the elements which satisfies the predicate, and then returns itself.
+func (f *FixedList[T]) RemoveWhere(handler Predicate[T]) IList[T] {
+	f.l.RemoveWhere(handler)
+	return f
+}
+
+// Interval returns a new List with all elements between the *from* and *to* indexes.
+// If the interval is not within the FixedList bounds, panics.
+func (f *FixedList[T]) Interval(from, to int) IList[T] {
+	return f.l.Interval(from, to)
+}
+
+// TryInterval returns a new List with all elements between the *from* and *to* indexes.
+// If the interval is not within the FixedList bounds, ErrIndexOutOfRange is returned.
+func (f *FixedList[T]) TryInterval(from, to int) (IList[T], error) {
+	return f.l.TryInterval(from, to)
+}
+
+// Slice returns a new List with the elements from the *from* index (inclusive) to the *to* index (exclusive), taking every *step* element.
+// Negative indexes are counted from the end of the FixedList (-1 is the last element), and out-of-range bounds are clamped.
+// A negative step walks backwards, from *from* down to *to*. A zero step panics with ErrZeroStep.
+func (f *FixedList[T]) Slice(from, to, step int) IList[T] {
+	return f.l.Slice(from, to, step)
+}
+
+// String returns a string representation of the FixedList.
+func (f *FixedList[T]) String() string {
+	return f.l.String()
+}
+
+// Join returns the string representation of each element in the IList, separated by the given separator
+func (f *FixedList[T]) Join(separator string) string {
+	return f.l.Join(separator)
+}
+
+// Sort receives a Sorter function to sort its elements, and returns itself after sorted.
+// Sort is stable: elements considered equal by the Sorter keep their original order.
+func (f *FixedList[T]) Sort(sorter Sorter[T]) IList[T] {
+	f.l.Sort(sorter)
+	return f
+}
+
+// Clear removes all elements from the FixedList, making it empty, and then returns itself.
+// The capacity is kept.
+func (f *FixedList[T]) Clear() IList[T] {
+	f.evict(f.Length())
+	return f
+}
+
+// IsDynamicallySized returns false, as FixedList is a fixed-capacity implementation of IList
+func (f *FixedList[T]) IsDynamicallySized() bool {
+	return false
+}
+
+// IsThreadSafe returns false, as FixedList is not a thread-safe implementation of IList
+func (f *FixedList[T]) IsThreadSafe() bool {
+	return false
+}
+
+func (f *FixedList[T]) UnmarshalJSON(data []byte) error {
+	var elements []T
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return err
+	}
+	f.Clear().Push(elements...)
+	return nil
+}
+
+func (f *FixedList[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(f.l)
+}
+
+// stored returns which of the given elements Push actually kept, by comparing the Length before the Push with the current one.
+// OverflowDiscard keeps the first ones which fit, and OverflowEvict the last ones, up to its capacity.
+func (f *FixedList[T]) stored(before int, elements []T) []T {
+	added := f.Length() - before
+	if added == len(elements) {
+		return elements
+	}
+	if f.overflow == OverflowEvict {
+		if kept := f.Length(); kept < len(elements) {
+			return elements[len(elements)-kept:]
+		}
+		return elements
+	}
+	return elements[:added]
+}
+
+// evict removes the given amount of elements from the beginning of the FixedList, without reallocating its backing array.
+func (f *FixedList[T]) evict(amount int) {
+	if amount <= 0 {
+		return
+	}
+	elements := f.Elements()
+	if amount > len(elements) {
+		amount = len(elements)
+	}
+	kept := copy(elements, elements[amount:])
+	var zero T
+	for i := kept; i < len(elements); i++ {
+		elements[i] = zero
+	}
+	*f.l = elements[:kept]
+}
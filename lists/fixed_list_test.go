@@ -0,0 +1,196 @@
+package lists
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+type fixed interface {
+	IList[any]
+	Capacity() int
+	Remaining() int
+	TryPush(...any) error
+}
+
+var fixedCases = []listTestCase[string]{
+	{
+		name:     "FixedList.Push",
+		input:    NewFixedList[any](3, 1, 2),
+		expected: "[1 2 3]",
+		runnable: func(t *testing.T, list IList[any], parameters []any) string {
+			return list.Push(3).String()
+		},
+	},
+	{
+		name:        "FixedList.Push.Overflow",
+		input:       NewFixedList[any](3, 1, 2, 3),
+		expectPanic: true,
+		runnable: func(t *testing.T, list IList[any], parameters []any) string {
+			return list.Push(4).String()
+		},
+	},
+	{
+		name:     "FixedList.TryPush.Overflow",
+		input:    NewFixedList[any](3, 1, 2),
+		expected: "[1 2]",
+		runnable: func(t *testing.T, list IList[any], parameters []any) string {
+			if err := list.(fixed).TryPush(3, 4); !errors.Is(err, ErrCapacityExceeded) {
+				t.Errorf("TryPush should return ErrCapacityExceeded. Got: %v", err)
+			}
+			return list.String()
+		},
+	},
+	{
+		name:     "FixedList.Shift.KeepsCapacity",
+		input:    NewFixedList[any](3, 1, 2, 3),
+		expected: "[2 3 4]",
+		runnable: func(t *testing.T, list IList[any], parameters []any) string {
+			list.Shift().Push(4)
+			if list.(fixed).Remaining() != 0 {
+				t.Error("FixedList should be full")
+			}
+			return list.String()
+		},
+	},
+	{
+		name:     "FixedList.Clear.KeepsCapacity",
+		input:    NewFixedList[any](3, 1, 2, 3),
+		expected: "[4 5 6]",
+		runnable: func(t *testing.T, list IList[any], parameters []any) string {
+			return list.Clear().Push(4, 5, 6).String()
+		},
+	},
+	{
+		name:     "FixedList.Clone",
+		input:    NewFixedList[any](3, 1, 2),
+		expected: "3",
+		runnable: func(t *testing.T, list IList[any], parameters []any) string {
+			return fmt.Sprint(list.Clone().(fixed).Capacity())
+		},
+	},
+	{
+		name:        "FixedList.InsertAt.Overflow",
+		input:       NewFixedList[any](3, 1, 2, 3).OnOverflow(OverflowEvict),
+		expectPanic: true,
+		runnable: func(t *testing.T, list IList[any], parameters []any) string {
+			return list.InsertAt(0, 0).String()
+		},
+	},
+	{
+		name:     "FixedList.Splice",
+		input:    NewFixedList[any](3, 1, 2, 3),
+		expected: "[1 4 5]",
+		runnable: func(t *testing.T, list IList[any], parameters []any) string {
+			list.Splice(1, 2, 4, 5)
+			return list.String()
+		},
+	},
+	{
+		name:     "FixedList.IsDynamicallySized",
+		input:    NewFixedList[any](3),
+		expected: "false",
+		runnable: func(t *testing.T, list IList[any], parameters []any) string {
+			return fmt.Sprint(list.IsDynamicallySized())
+		},
+	},
+	{
+		name:     "FixedList.UnmarshallJSON",
+		input:    NewFixedList[any](3),
+		expected: "[1,2,3]",
+		runnable: func(t *testing.T, list IList[any], parameters []any) string {
+			if err := json.Unmarshal([]byte("[1, 2, 3]"), list); err != nil {
+				panic(err)
+			}
+			bytes, err := json.Marshal(list)
+			if err != nil {
+				panic(err)
+			}
+			return string(bytes)
+		},
+	},
+}
+
+var overflowCases = []listTestCase[string]{
+	{
+		name:     "FixedList.OnOverflow.Discard",
+		input:    NewFixedList[any](3, 1, 2).OnOverflow(OverflowDiscard),
+		expected: "[1 2 3]",
+		runnable: func(t *testing.T, list IList[any], parameters []any) string {
+			return list.Push(3, 4, 5).String()
+		},
+	},
+	{
+		name:     "FixedList.OnOverflow.Evict",
+		input:    NewFixedList[any](3, 1, 2).OnOverflow(OverflowEvict),
+		expected: "[3 4 5]",
+		runnable: func(t *testing.T, list IList[any], parameters []any) string {
+			return list.Push(3, 4, 5).String()
+		},
+	},
+	{
+		name:     "FixedList.OnOverflow.Evict.All",
+		input:    NewFixedList[any](3, 1, 2).OnOverflow(OverflowEvict),
+		expected: "[5 6 7]",
+		runnable: func(t *testing.T, list IList[any], parameters []any) string {
+			return list.Push(3, 4, 5, 6, 7).String()
+		},
+	},
+	{
+		name:     "SafeFixedList.OnOverflow.Evict",
+		input:    NewSafeFixedList[any](3, 1, 2).OnOverflow(OverflowEvict),
+		expected: "[3 4 5]",
+		runnable: func(t *testing.T, list IList[any], parameters []any) string {
+			return list.Push(3, 4, 5).String()
+		},
+	},
+}
+
+func TestFixed(t *testing.T) {
+	for _, v := range fixedCases {
+		safe := cloneSafeFixed(v)
+		caseRunner[string](t, v)
+		caseRunner[string](t, safe)
+	}
+}
+
+func TestOverflow(t *testing.T) {
+	for _, v := range overflowCases {
+		caseRunner[string](t, v)
+	}
+}
+
+func TestFixed_InvalidCapacity(t *testing.T) {
+	for name, create := range map[string]func(int){
+		"NewFixedList":         func(capacity int) { NewFixedList(capacity, 1) },
+		"NewFixedListFrom":     func(capacity int) { NewFixedListFrom(capacity, []int{1}) },
+		"NewSafeFixedList":     func(capacity int) { NewSafeFixedList(capacity, 1) },
+		"NewSafeFixedListFrom": func(capacity int) { NewSafeFixedListFrom(capacity, []int{1}) },
+	} {
+		for _, capacity := range []int{0, -1} {
+			func() {
+				defer func() {
+					if r := recover(); r != ErrInvalidSize {
+						t.Errorf("%v(%v) should panic with ErrInvalidSize. Got: %v", name, capacity, r)
+					}
+				}()
+				create(capacity)
+			}()
+		}
+	}
+}
+
+func cloneSafeFixed[T comparable](t listTestCase[T]) listTestCase[T] {
+	f := t.input.(*FixedList[any])
+	return listTestCase[T]{
+		name:              strings.Replace(t.name, "FixedList", "SafeFixedList", -1),
+		input:             NewSafeFixedList[any](f.Capacity(), f.Elements()...).OnOverflow(f.overflow),
+		parameters:        t.parameters,
+		expectPanic:       t.expectPanic,
+		expected:          t.expected,
+		runnable:          t.runnable,
+		nilTypeComparison: t.nilTypeComparison,
+	}
+}
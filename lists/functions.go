@@ -6,14 +6,166 @@ type Mapper[T any] func(T) any
 
 type Reducer[T any] func(any, T, int) any
 
+type Consumer[T any] func(T, int)
+
 type Sorter[T any] func(T, T) int
 
 type TypeMapper[F, T any] func(F) T
 
+type IndexedTypeMapper[F, T any] func(F, int) T
+
+type IndexedPredicate[T any] func(T, int) bool
+
+type FlatMapper[F, T any] func(F) IList[T]
+
+type Folder[T, A any] func(A, T, int) A
+
+// TypeMap iterates over the elements of the given IList calling TypeMapper, and return a new IList with the results.
+// It is equivalent to MapTo.
 func TypeMap[F, T any](list IList[F], mapper TypeMapper[F, T]) IList[T] {
+	return MapTo(list, mapper)
+}
+
+// MapTo iterates over the elements of the given IList calling TypeMapper, and return a new IList with the typed results.
+func MapTo[F, T any](list IList[F], mapper TypeMapper[F, T]) IList[T] {
+	elements := elementsOf(list)
+	mapped := make(List[T], 0, len(elements))
+	for _, v := range elements {
+		mapped = append(mapped, mapper(v))
+	}
+	return &mapped
+}
+
+// MapIndexed iterates over the elements of the given IList calling IndexedTypeMapper with each element and its index,
+// and return a new IList with the typed results.
+func MapIndexed[F, T any](list IList[F], mapper IndexedTypeMapper[F, T]) IList[T] {
+	elements := elementsOf(list)
+	mapped := make(List[T], 0, len(elements))
+	for i, v := range elements {
+		mapped = append(mapped, mapper(v, i))
+	}
+	return &mapped
+}
+
+// FlatMap iterates over the elements of the given IList calling FlatMapper,
+// and return a new IList with the elements of all resulting ILists, in order.
+func FlatMap[F, T any](list IList[F], mapper FlatMapper[F, T]) IList[T] {
 	mapped := NewList[T]()
-	for _, v := range list.Elements() {
-		mapped.Push(mapper(v))
+	for _, v := range elementsOf(list) {
+		mapped.Push(elementsOf(mapper(v))...)
 	}
 	return mapped
 }
+
+// Fold executes the Folder for each element from the list with the given accumulator, and each result will be the accumulator for the next.
+// The final result will be returned. It is the typed counterpart of IList.Reduce.
+func Fold[T, A any](list IList[T], folder Folder[T, A], accumulator A) A {
+	for i, v := range elementsOf(list) {
+		accumulator = folder(accumulator, v, i)
+	}
+	return accumulator
+}
+
+// ReduceRight executes the Folder for each element from the list, from the last to the first, with the given accumulator,
+// and each result will be the accumulator for the next. The final result will be returned.
+func ReduceRight[T, A any](list IList[T], folder Folder[T, A], accumulator A) A {
+	elements := elementsOf(list)
+	for i := len(elements) - 1; i >= 0; i-- {
+		accumulator = folder(accumulator, elements[i], i)
+	}
+	return accumulator
+}
+
+// Partition returns two ILists: the first with the elements which satisfies the predicate, and the second with the remaining ones.
+// Both keep the order of the given IList, and are of its same implementation (see Clone), unless it has a fixed capacity (see FixedList).
+func Partition[T any](list IList[T], handler Predicate[T]) (IList[T], IList[T]) {
+	var satisfied, remaining []T
+	for _, v := range elementsOf(list) {
+		if handler(v) {
+			satisfied = append(satisfied, v)
+		} else {
+			remaining = append(remaining, v)
+		}
+	}
+	return like(list, satisfied), like(list, remaining)
+}
+
+// WhereIndexed returns a IList with all the elements which satisfies the IndexedPredicate, called with each element and its index.
+// It keeps the order of the given IList, and is of its same implementation (see Clone), unless it has a fixed capacity (see FixedList).
+func WhereIndexed[T any](list IList[T], handler IndexedPredicate[T]) IList[T] {
+	var satisfied []T
+	for i, v := range elementsOf(list) {
+		if handler(v, i) {
+			satisfied = append(satisfied, v)
+		}
+	}
+	return like(list, satisfied)
+}
+
+// EveryIndexed returns true if every element in the given IList satisfies the IndexedPredicate, called with each element and its index.
+func EveryIndexed[T any](list IList[T], handler IndexedPredicate[T]) bool {
+	for i, v := range elementsOf(list) {
+		if !handler(v, i) {
+			return false
+		}
+	}
+	return true
+}
+
+// MapErr iterates over the elements of the given IList calling the mapper, and return a new IList with the typed results.
+// It stops at the first error returned by the mapper, and returns it.
+func MapErr[F, T any](list IList[F], mapper func(F) (T, error)) (IList[T], error) {
+	elements := elementsOf(list)
+	mapped := make(List[T], 0, len(elements))
+	for _, v := range elements {
+		result, err := mapper(v)
+		if err != nil {
+			return nil, err
+		}
+		mapped = append(mapped, result)
+	}
+	return &mapped, nil
+}
+
+// WhereErr returns a IList with all the elements which satisfies the predicate, keeping the order of the given IList, and of its same implementation (see Clone), unless it has a fixed capacity (see FixedList).
+// It stops at the first error returned by the predicate, and returns it.
+func WhereErr[T any](list IList[T], handler func(T) (bool, error)) (IList[T], error) {
+	var satisfied []T
+	for _, v := range elementsOf(list) {
+		ok, err := handler(v)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			satisfied = append(satisfied, v)
+		}
+	}
+	return like(list, satisfied), nil
+}
+
+// ForEachErr calls the given function with each element of the given IList, in order.
+// It stops at the first error returned by the function, and returns it.
+func ForEachErr[T any](list IList[T], handler func(T) error) error {
+	for _, v := range elementsOf(list) {
+		if err := handler(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// elementsOf returns the elements of the given IList to be iterated over.
+// Thread-safe implementations are copied under their own lock first (see SafeList.Snapshot), so the iteration runs over a consistent copy,
+// and callbacks are free to use the original IList. Implementations with immutable views (see CopyOnWriteList) are not copied at all.
+func elementsOf[T any](list IList[T]) []T {
+	if v, ok := list.(interface{ view() *List[T] }); ok {
+		return v.view().Elements()
+	}
+	if s, ok := list.(interface{ snapshot() *List[T] }); ok {
+		return s.snapshot().Elements()
+	}
+	if list.IsThreadSafe() {
+		return list.Clone().Elements()
+	}
+	return list.Elements()
+}
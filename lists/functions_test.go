@@ -0,0 +1,150 @@
+package lists
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+)
+
+func TestMapTo(t *testing.T) {
+	for _, list := range []IList[int]{NewList(1, 2, 3), NewSafeList(1, 2, 3)} {
+		mapped := MapTo(list, func(v int) string {
+			return fmt.Sprint(v * 2)
+		})
+		if mapped.Join(",") != "2,4,6" {
+			t.Errorf("MapTo: expected 2,4,6. Got: %v", mapped.Join(","))
+		}
+	}
+}
+
+func TestMapIndexed(t *testing.T) {
+	mapped := MapIndexed[string, string](NewList("a", "b"), func(v string, i int) string {
+		return fmt.Sprint(v, i)
+	})
+	if mapped.Join(",") != "a0,b1" {
+		t.Errorf("MapIndexed: expected a0,b1. Got: %v", mapped.Join(","))
+	}
+}
+
+func TestFlatMap(t *testing.T) {
+	mapped := FlatMap[int, int](NewSafeList(1, 2, 3), func(v int) IList[int] {
+		return NewList(v, v*10)
+	})
+	if mapped.Join(",") != "1,10,2,20,3,30" {
+		t.Errorf("FlatMap: expected 1,10,2,20,3,30. Got: %v", mapped.Join(","))
+	}
+}
+
+func TestFold(t *testing.T) {
+	list := NewSafeList(1, 2, 3)
+	sum := Fold[int, int](list, func(acc int, v int, i int) int {
+		// callbacks may safely use the SafeList, as it is not locked during the fold
+		return acc + v + list.Length()
+	}, 0)
+	if sum != 15 {
+		t.Errorf("Fold: expected 15. Got: %v", sum)
+	}
+}
+
+func TestReduceRight(t *testing.T) {
+	joined := ReduceRight[string, string](NewList("a", "b", "c"), func(acc string, v string, i int) string {
+		return acc + v
+	}, "")
+	if joined != "cba" {
+		t.Errorf("ReduceRight: expected cba. Got: %v", joined)
+	}
+}
+
+func TestPartition(t *testing.T) {
+	even, odd := Partition[int](NewSafeList(1, 2, 3, 4, 5), func(v int) bool {
+		return v%2 == 0
+	})
+	if even.Join(",") != "2,4" || odd.Join(",") != "1,3,5" {
+		t.Errorf("Partition: expected 2,4 and 1,3,5. Got: %v and %v", even, odd)
+	}
+	if _, is := odd.(*SafeList[int]); !is {
+		t.Errorf("Partition should keep the SafeList implementation. Got: %T", odd)
+	}
+}
+
+func TestWhereIndexed(t *testing.T) {
+	for _, list := range []IList[string]{NewList("a", "b", "c", "d", "e"), NewSafeList("a", "b", "c", "d", "e")} {
+		everyOther := WhereIndexed(list, func(v string, i int) bool {
+			return i%2 == 0
+		})
+		if everyOther.Join(",") != "a,c,e" || everyOther.IsThreadSafe() != list.IsThreadSafe() {
+			t.Errorf("WhereIndexed: expected a,c,e. Got: %v", everyOther)
+		}
+	}
+}
+
+func TestEveryIndexed(t *testing.T) {
+	list := NewSafeList(0, 1, 2, 4)
+	calls := 0
+	every := EveryIndexed[int](list, func(v int, i int) bool {
+		calls++
+		return v == i
+	})
+	if every || calls != 4 {
+		t.Errorf("EveryIndexed: expected false after 4 calls. Got: %v after %v", every, calls)
+	}
+	if !EveryIndexed[int](list.Clone().Pop(), func(v int, i int) bool { return v == i }) {
+		t.Error("EveryIndexed: expected true")
+	}
+}
+
+func TestMapErr(t *testing.T) {
+	for _, list := range []IList[string]{NewList("1", "2"), NewSafeList("1", "2")} {
+		mapped, err := MapErr(list, strconv.Atoi)
+		if err != nil || mapped.Join(",") != "1,2" {
+			t.Errorf("MapErr: expected 1,2. Got: %v, %v", mapped, err)
+		}
+	}
+	calls := 0
+	mapped, err := MapErr[string, int](NewList("1", "x", "3"), func(v string) (int, error) {
+		calls++
+		return strconv.Atoi(v)
+	})
+	if !errors.Is(err, strconv.ErrSyntax) || mapped != nil || calls != 2 {
+		t.Errorf("MapErr should stop at the first error. Got: %v after %v calls", err, calls)
+	}
+}
+
+func TestWhereErr(t *testing.T) {
+	invalid := errors.New("invalid")
+	handler := func(v int) (bool, error) {
+		if v < 0 {
+			return false, invalid
+		}
+		return v%2 == 0, nil
+	}
+	even, err := WhereErr[int](NewSafeList(1, 2, 3, 4), handler)
+	if err != nil || even.Join(",") != "2,4" || !even.IsThreadSafe() {
+		t.Errorf("WhereErr: expected 2,4. Got: %v, %v", even, err)
+	}
+	if even, err = WhereErr[int](NewList(2, -1, 4), handler); err != invalid || even != nil {
+		t.Errorf("WhereErr should stop at the first error. Got: %v, %v", even, err)
+	}
+}
+
+func TestForEachErr(t *testing.T) {
+	list := NewSafeList(1, 2, 3)
+	stop := errors.New("stop")
+	visited := NewList[int]()
+	err := ForEachErr[int](list, func(v int) error {
+		// callbacks may safely use the SafeList, as it is not locked during the iteration
+		list.Push(v)
+		visited.Push(v)
+		if v == 2 {
+			return stop
+		}
+		return nil
+	})
+	if err != stop || visited.Join(",") != "1,2" || list.Length() != 5 {
+		t.Errorf("ForEachErr should stop at the first error. Got: %v after %v", err, visited)
+	}
+	if err := ForEachErr[int](NewList(1), func(int) error { return nil }); err != nil {
+		t.Errorf("ForEachErr: expected no error. Got: %v", err)
+	}
+}
@@ -0,0 +1,581 @@
+package lists
+
+import (
+	"context"
+	"encoding/json"
+)
+
+const (
+	// trieBits is how many index bits each level of the ImmutableList trie consumes.
+	trieBits = 5
+
+	// trieWidth is how many children (or elements, for leaves) each node of the ImmutableList trie holds.
+	trieWidth = 1 << trieBits
+
+	// trieMask selects the index bits of a single trie level.
+	trieMask = trieWidth - 1
+)
+
+// NewImmutableList returns a new ImmutableList with the given elements
+func NewImmutableList[T any](elements ...T) *ImmutableList[T] {
+	return (&ImmutableList[T]{}).push(elements)
+}
+
+// NewImmutableListFrom returns a new ImmutableList with the given slice
+func NewImmutableListFrom[T any](elements []T) *ImmutableList[T] {
+	return NewImmutableList(elements...)
+}
+
+// ImmutableList is a persistent and thread-safe list.
+// Methods which would change the list (such as Push, Set, Pop, Shift, Sort and Clear) leave it untouched,
+// and return a new version instead, so every version stays valid and may be used concurrently.
+// As IList methods change the list in place, ImmutableList does not implement IList, although it mirrors its methods:
+// see ToList, to use its elements where an IList is expected.
+//
+// Versions share their structure: elements are stored in a trie of 32-element leaves, plus a tail leaf,
+// so Push, Pop and Set copy only the O(log n) path they change, and Shift is O(1).
+// Changes in the middle of the list (such as InsertAt, RemoveRange and Sort) build a new version in O(n).
+// Pointers returned by At, First, Last, ... point to copies, so they can not be used to change it.
+// The zero value is an empty ImmutableList.
+type ImmutableList[T any] struct {
+	root   *trieNode[T]
+	tail   []T
+	count  int
+	offset int
+	shift  uint
+}
+
+// trieNode is a node of the ImmutableList trie. Leaves hold values, and the other nodes hold children.
+// Nodes are never changed once reachable from an ImmutableList.
+type trieNode[T any] struct {
+	children []*trieNode[T]
+	values   []T
+}
+
+// Length returns how many elements are in the ImmutableList.
+func (i *ImmutableList[T]) Length() int {
+	return i.count - i.offset
+}
+
+// IsEmpty returns true if there are *no* Elements stored in the ImmutableList.
+func (i *ImmutableList[T]) IsEmpty() bool {
+	return i.Length() == 0
+}
+
+// IsNotEmpty returns true if there are Elements stored in the ImmutableList.
+func (i *ImmutableList[T]) IsNotEmpty() bool {
+	return i.Length() > 0
+}
+
+// At returns the pointer of a copy of the element at the given index from the ImmutableList.
+// If there is no element at the given index, nil will be returned.
+func (i *ImmutableList[T]) At(index int) *T {
+	if index < 0 || index >= i.Length() {
+		return nil
+	}
+	v := i.get(index + i.offset)
+	return &v
+}
+
+// AtFromEnd returns the pointer of a copy of the element at the given offset from the end of the ImmutableList:
+// AtFromEnd(0) is the last element. If there is no element at the given offset, nil will be returned.
+func (i *ImmutableList[T]) AtFromEnd(index int) *T {
+	return i.At(i.Length() - 1 - index)
+}
+
+// ElementAt returns the element at the given index from the ImmutableList.
+// If there is no element at the given index, panics.
+func (i *ImmutableList[T]) ElementAt(index int) T {
+	return must(i.TryElementAt(index))
+}
+
+// TryElementAt returns the element at the given index from the ImmutableList.
+// If there is no element at the given index, ErrIndexOutOfRange is returned.
+func (i *ImmutableList[T]) TryElementAt(index int) (T, error) {
+	at := i.At(index)
+	if at == nil {
+		var zero T
+		return zero, outOfRange(index, i.Length())
+	}
+	return *at, nil
+}
+
+// Elements returns a new built-in slice with all elements in the ImmutableList.
+func (i *ImmutableList[T]) Elements() []T {
+	elements := make([]T, 0, i.Length())
+	for p := i.offset; p < i.count; p += trieWidth - p&trieMask {
+		elements = append(elements, i.leafFor(p)[p&trieMask:]...)
+	}
+	return elements
+}
+
+// Push returns a new version of the ImmutableList with the given elements added at its end.
+func (i *ImmutableList[T]) Push(elements ...T) *ImmutableList[T] {
+	return i.push(elements)
+}
+
+// Clone returns the ImmutableList itself, as it is never changed.
+func (i *ImmutableList[T]) Clone() *ImmutableList[T] {
+	return i
+}
+
+// FirstElement returns the first element in the ImmutableList.
+// If ImmutableList is empty (see IsEmpty), panics
+func (i *ImmutableList[T]) FirstElement() T {
+	return must(i.TryFirstElement())
+}
+
+// TryFirstElement returns the first element in the ImmutableList.
+// If ImmutableList is empty (see IsEmpty), ErrEmpty is returned.
+func (i *ImmutableList[T]) TryFirstElement() (T, error) {
+	if i.IsEmpty() {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return i.ElementAt(0), nil
+}
+
+// First returns the pointer of a copy of the first element in the ImmutableList.
+// If ImmutableList is empty (see IsEmpty), nil will be returned.
+func (i *ImmutableList[T]) First() *T {
+	return i.At(0)
+}
+
+// LastElement returns the last element in the ImmutableList.
+// If ImmutableList is empty (see IsEmpty), panics
+func (i *ImmutableList[T]) LastElement() T {
+	return must(i.TryLastElement())
+}
+
+// TryLastElement returns the last element in the ImmutableList.
+// If ImmutableList is empty (see IsEmpty), ErrEmpty is returned.
+func (i *ImmutableList[T]) TryLastElement() (T, error) {
+	if i.IsEmpty() {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return i.ElementAt(i.Length() - 1), nil
+}
+
+// Last returns the pointer of a copy of the last element in the ImmutableList.
+// If ImmutableList is empty (see IsEmpty), nil will be returned.
+func (i *ImmutableList[T]) Last() *T {
+	return i.AtFromEnd(0)
+}
+
+// FirstIndexWhere returns the index of the first element which satisfies the predicate.
+// If no element satisfies the predicate, -1 will be returned.
+func (i *ImmutableList[T]) FirstIndexWhere(handler Predicate[T]) int {
+	return i.list().FirstIndexWhere(handler)
+}
+
+// FirstWhere returns the pointer of a copy of the first element which satisfies the predicate.
+// If no element satisfies the predicate, nil will be returned.
+func (i *ImmutableList[T]) FirstWhere(handler Predicate[T]) *T {
+	return i.list().FirstWhere(handler)
+}
+
+// FirstElementWhere returns the first element which satisfies the predicate.
+// If no element satisfies the predicate, panics.
+func (i *ImmutableList[T]) FirstElementWhere(handler Predicate[T]) T {
+	return must(i.TryFirstElementWhere(handler))
+}
+
+// TryFirstElementWhere returns the first element which satisfies the predicate.
+// If no element satisfies the predicate, ErrNotFound is returned.
+func (i *ImmutableList[T]) TryFirstElementWhere(handler Predicate[T]) (T, error) {
+	return i.list().TryFirstElementWhere(handler)
+}
+
+// LastIndexWhere returns the index of the last element which satisfies the predicate.
+// If no element satisfies the predicate, -1 will be returned.
+func (i *ImmutableList[T]) LastIndexWhere(handler Predicate[T]) int {
+	return i.list().LastIndexWhere(handler)
+}
+
+// LastWhere returns the pointer of a copy of the last element which satisfies the predicate.
+// If no element satisfies the predicate, nil will be returned.
+func (i *ImmutableList[T]) LastWhere(handler Predicate[T]) *T {
+	return i.list().LastWhere(handler)
+}
+
+// LastElementWhere returns the last element which satisfies the predicate.
+// If no element satisfies the predicate, panics.
+func (i *ImmutableList[T]) LastElementWhere(handler Predicate[T]) T {
+	return must(i.TryLastElementWhere(handler))
+}
+
+// TryLastElementWhere returns the last element which satisfies the predicate.
+// If no element satisfies the predicate, ErrNotFound is returned.
+func (i *ImmutableList[T]) TryLastElementWhere(handler Predicate[T]) (T, error) {
+	return i.list().TryLastElementWhere(handler)
+}
+
+// IndexWhere returns a new List with the indexes of all elements which satisfies the predicate.
+func (i *ImmutableList[T]) IndexWhere(handler Predicate[T]) IList[int] {
+	return i.list().IndexWhere(handler)
+}
+
+// Where returns a new List with only the elements which satisfies the predicate.
+func (i *ImmutableList[T]) Where(handler Predicate[T]) IList[T] {
+	return i.list().Where(handler)
+}
+
+// Map iterates over the elements of the ImmutableList calling Mapper, and return a new List with the results.
+func (i *ImmutableList[T]) Map(handler Mapper[T]) IList[any] {
+	return i.list().Map(handler)
+}
+
+// Reduce executes the Reducer for each element from the ImmutableList with the given accumulator, and each result will be the accumulator for the next.
+// The final result will be returned.
+func (i *ImmutableList[T]) Reduce(reducer Reducer[T], accumulator any) any {
+	return i.list().Reduce(reducer, accumulator)
+}
+
+// Every returns true if every element in the ImmutableList satisfies the predicate.
+func (i *ImmutableList[T]) Every(handler Predicate[T]) bool {
+	return i.list().Every(handler)
+}
+
+// Some returns true if at least one element in the ImmutableList satisfies the predicate.
+func (i *ImmutableList[T]) Some(handler Predicate[T]) bool {
+	return i.list().Some(handler)
+}
+
+// None returns true if no element in the ImmutableList satisfies the predicate.
+func (i *ImmutableList[T]) None(handler Predicate[T]) bool {
+	return i.list().None(handler)
+}
+
+// ForEach calls the Consumer with each element of the ImmutableList and its index, in order.
+func (i *ImmutableList[T]) ForEach(handler Consumer[T]) {
+	i.list().ForEach(handler)
+}
+
+// ForEachUntil calls the IndexedPredicate with each element of the ImmutableList and its index, in order, until it returns true.
+func (i *ImmutableList[T]) ForEachUntil(handler IndexedPredicate[T]) {
+	i.list().ForEachUntil(handler)
+}
+
+// ForEachContext calls the Consumer with each element of the ImmutableList and its index, in order, checking the context before each call.
+// Once the context is done, it stops and returns the context error.
+func (i *ImmutableList[T]) ForEachContext(ctx context.Context, handler Consumer[T]) error {
+	return i.list().ForEachContext(ctx, handler)
+}
+
+// Pop returns a new version of the ImmutableList without its last element.
+// If ImmutableList is empty (see IsEmpty), panics.
+func (i *ImmutableList[T]) Pop() *ImmutableList[T] {
+	return must(i.TryPop())
+}
+
+// TryPop returns a new version of the ImmutableList without its last element.
+// If ImmutableList is empty (see IsEmpty), it is returned itself along with ErrEmpty.
+func (i *ImmutableList[T]) TryPop() (*ImmutableList[T], error) {
+	if i.IsEmpty() {
+		return i, ErrEmpty
+	}
+	return i.pop(), nil
+}
+
+// Shift returns a new version of the ImmutableList without its first element.
+// If ImmutableList is empty (see IsEmpty), panics.
+func (i *ImmutableList[T]) Shift() *ImmutableList[T] {
+	return must(i.TryShift())
+}
+
+// TryShift returns a new version of the ImmutableList without its first element.
+// If ImmutableList is empty (see IsEmpty), it is returned itself along with ErrEmpty.
+func (i *ImmutableList[T]) TryShift() (*ImmutableList[T], error) {
+	if i.IsEmpty() {
+		return i, ErrEmpty
+	}
+	if i.Length() == 1 {
+		return &ImmutableList[T]{}, nil
+	}
+	shifted := *i
+	shifted.offset++
+	if shifted.offset >= trieWidth && shifted.offset*2 >= shifted.count {
+		return NewImmutableList(shifted.Elements()...), nil
+	}
+	return &shifted, nil
+}
+
+// Set returns a new version of the ImmutableList with the given element at the given index.
+// If there is no element at the given index, panics.
+func (i *ImmutableList[T]) Set(index int, element T) *ImmutableList[T] {
+	return must(i.TrySet(index, element))
+}
+
+// TrySet returns a new version of the ImmutableList with the given element at the given index.
+// If there is no element at the given index, it is returned itself along with ErrIndexOutOfRange.
+func (i *ImmutableList[T]) TrySet(index int, element T) (*ImmutableList[T], error) {
+	if index < 0 || index >= i.Length() {
+		return i, outOfRange(index, i.Length())
+	}
+	return i.set(index+i.offset, element), nil
+}
+
+// InsertAt returns a new version of the ImmutableList with the given elements inserted at the given index.
+// The index may be equal to Length, to insert at the end. Otherwise, if there is no element at the given index, panics.
+func (i *ImmutableList[T]) InsertAt(index int, elements ...T) *ImmutableList[T] {
+	return i.rebuild(i.list().InsertAt(index, elements...))
+}
+
+// RemoveAt returns a new version of the ImmutableList without the element at the given index.
+// If there is no element at the given index, panics.
+func (i *ImmutableList[T]) RemoveAt(index int) *ImmutableList[T] {
+	return i.RemoveRange(index, index)
+}
+
+// RemoveRange returns a new version of the ImmutableList without the elements between the *from* and *to* indexes.
+// If the interval is not within the ImmutableList bounds, panics.
+func (i *ImmutableList[T]) RemoveRange(from, to int) *ImmutableList[T] {
+	return i.rebuild(i.list().RemoveRange(from, to))
+}
+
+// Splice returns a new version of the ImmutableList, with deleteCount elements removed starting at the *start* index,
+// and the given elements inserted in their place, along with a new List of the removed elements.
+// deleteCount is limited to the elements available after start.
+// The start index may be equal to Length, to insert at the end. Otherwise, if there is no element at the given index, panics.
+func (i *ImmutableList[T]) Splice(start, deleteCount int, elements ...T) (*ImmutableList[T], IList[T]) {
+	l := i.list()
+	removed := l.Splice(start, deleteCount, elements...)
+	return NewImmutableList(l.Elements()...), removed
+}
+
+// RemoveWhere returns a new version of the ImmutableList without the elements which satisfies the predicate.
+func (i *ImmutableList[T]) RemoveWhere(handler Predicate[T]) *ImmutableList[T] {
+	return i.rebuild(i.list().RemoveWhere(handler))
+}
+
+// Interval returns a new List with all elements between the *from* and *to* indexes.
+// If the interval is not within the ImmutableList bounds, panics.
+func (i *ImmutableList[T]) Interval(from, to int) IList[T] {
+	return must(i.TryInterval(from, to))
+}
+
+// TryInterval returns a new List with all elements between the *from* and *to* indexes.
+// If the interval is not within the ImmutableList bounds, ErrIndexOutOfRange is returned.
+func (i *ImmutableList[T]) TryInterval(from, to int) (IList[T], error) {
+	return i.list().TryInterval(from, to)
+}
+
+// Slice returns a new List with the elements from the *from* index (inclusive) to the *to* index (exclusive), taking every *step* element.
+// Negative indexes are counted from the end of the ImmutableList (-1 is the last element), and out-of-range bounds are clamped.
+// A negative step walks backwards, from *from* down to *to*. A zero step panics with ErrZeroStep.
+func (i *ImmutableList[T]) Slice(from, to, step int) IList[T] {
+	return i.list().Slice(from, to, step)
+}
+
+// String returns a string representation of the ImmutableList.
+func (i *ImmutableList[T]) String() string {
+	return i.list().String()
+}
+
+// Join returns the string representation of each element in the ImmutableList, separated by the given separator
+func (i *ImmutableList[T]) Join(separator string) string {
+	return i.list().Join(separator)
+}
+
+// Sort returns a new version of the ImmutableList, sorted by the given Sorter.
+// Sort is stable: elements considered equal by the Sorter keep their original order.
+func (i *ImmutableList[T]) Sort(sorter Sorter[T]) *ImmutableList[T] {
+	return i.rebuild(i.list().Sort(sorter))
+}
+
+// Clear returns a new, empty, version of the ImmutableList.
+func (i *ImmutableList[T]) Clear() *ImmutableList[T] {
+	return &ImmutableList[T]{}
+}
+
+// ToList returns a new List with the elements of the ImmutableList.
+func (i *ImmutableList[T]) ToList() IList[T] {
+	return i.list()
+}
+
+// IsDynamicallySized returns true, as ImmutableList is dynamically-sized
+func (i *ImmutableList[T]) IsDynamicallySized() bool {
+	return true
+}
+
+// IsThreadSafe returns true, as ImmutableList is never changed, and so is thread-safe
+func (i *ImmutableList[T]) IsThreadSafe() bool {
+	return true
+}
+
+// UnmarshalJSON replaces the ImmutableList with the decoded elements.
+// It is the only method which changes the ImmutableList in place, so it is not safe for concurrent use:
+// it may only decode into a new ImmutableList, which is not yet shared with other goroutines or versions.
+func (i *ImmutableList[T]) UnmarshalJSON(data []byte) error {
+	var elements []T
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return err
+	}
+	*i = *NewImmutableList(elements...)
+	return nil
+}
+
+func (i *ImmutableList[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.Elements())
+}
+
+// list returns a new List with the elements of the ImmutableList.
+func (i *ImmutableList[T]) list() *List[T] {
+	return NewListFrom(i.Elements())
+}
+
+// rebuild returns a new ImmutableList with the elements of the given IList.
+func (i *ImmutableList[T]) rebuild(list IList[T]) *ImmutableList[T] {
+	return NewImmutableList(list.Elements()...)
+}
+
+// tailOffset returns the index of the first element in the tail leaf.
+func (i *ImmutableList[T]) tailOffset() int {
+	return i.count - len(i.tail)
+}
+
+// leafFor returns the leaf holding the element at the given position, which counts the shifted elements.
+func (i *ImmutableList[T]) leafFor(position int) []T {
+	if position >= i.tailOffset() {
+		return i.tail
+	}
+	n := i.root
+	for level := i.shift; level > 0; level -= trieBits {
+		n = n.children[(position>>level)&trieMask]
+	}
+	return n.values
+}
+
+// get returns the element at the given position, which counts the shifted elements.
+func (i *ImmutableList[T]) get(position int) T {
+	return i.leafFor(position)[position&trieMask]
+}
+
+// push returns a new version with the given elements added at its end, copying only the tail and the trie path to it.
+func (i *ImmutableList[T]) push(elements []T) *ImmutableList[T] {
+	pushed := *i
+	for len(elements) > 0 {
+		if len(pushed.tail) == trieWidth {
+			pushed.pushTail()
+		}
+		room := trieWidth - len(pushed.tail)
+		if room > len(elements) {
+			room = len(elements)
+		}
+		tail := make([]T, len(pushed.tail), len(pushed.tail)+room)
+		copy(tail, pushed.tail)
+		pushed.tail = append(tail, elements[:room]...)
+		pushed.count += room
+		elements = elements[room:]
+	}
+	return &pushed
+}
+
+// pushTail moves the full tail leaf into the trie, growing it by one level when the root is full.
+func (i *ImmutableList[T]) pushTail() {
+	leaf := &trieNode[T]{values: i.tail}
+	switch {
+	case i.root == nil:
+		i.root = &trieNode[T]{children: []*trieNode[T]{leaf}}
+		i.shift = trieBits
+	case (i.tailOffset() >> trieBits) >= 1<<i.shift:
+		i.root = &trieNode[T]{children: []*trieNode[T]{i.root, newTriePath(i.shift, leaf)}}
+		i.shift += trieBits
+	default:
+		i.root = i.root.pushLeaf(i.shift, i.tailOffset(), leaf)
+	}
+	i.tail = nil
+}
+
+// pop returns a new version without its last element. When the tail gets empty, the last trie leaf becomes the tail.
+func (i *ImmutableList[T]) pop() *ImmutableList[T] {
+	if i.Length() == 1 {
+		return &ImmutableList[T]{}
+	}
+	popped := *i
+	popped.count--
+	if len(i.tail) > 1 {
+		popped.tail = i.tail[: len(i.tail)-1 : len(i.tail)-1]
+		return &popped
+	}
+	popped.tail = i.leafFor(i.count - 2)
+	popped.root = i.root.popLeaf(i.shift, i.count-2)
+	if popped.root == nil {
+		popped.shift = 0
+	} else if popped.shift > trieBits && len(popped.root.children) == 1 {
+		popped.root = popped.root.children[0]
+		popped.shift -= trieBits
+	}
+	return &popped
+}
+
+// set returns a new version with the given element at the given position, copying only the leaf and the trie path to it.
+func (i *ImmutableList[T]) set(position int, element T) *ImmutableList[T] {
+	changed := *i
+	if position >= i.tailOffset() {
+		changed.tail = append([]T(nil), i.tail...)
+		changed.tail[position&trieMask] = element
+	} else {
+		changed.root = i.root.set(i.shift, position, element)
+	}
+	return &changed
+}
+
+// newTriePath returns a path of single-child nodes from the given level down to the given leaf.
+func newTriePath[T any](level uint, leaf *trieNode[T]) *trieNode[T] {
+	if level == 0 {
+		return leaf
+	}
+	return &trieNode[T]{children: []*trieNode[T]{newTriePath(level-trieBits, leaf)}}
+}
+
+// pushLeaf returns a copy of the node with the given leaf added at the given position.
+func (n *trieNode[T]) pushLeaf(level uint, position int, leaf *trieNode[T]) *trieNode[T] {
+	index := (position >> level) & trieMask
+	children := append([]*trieNode[T](nil), n.children...)
+	child := leaf
+	if level > trieBits {
+		if index < len(children) {
+			child = children[index].pushLeaf(level-trieBits, position, leaf)
+		} else {
+			child = newTriePath(level-trieBits, leaf)
+		}
+	}
+	if index < len(children) {
+		children[index] = child
+	} else {
+		children = append(children, child)
+	}
+	return &trieNode[T]{children: children}
+}
+
+// popLeaf returns a copy of the node without the leaf holding the given position, which must be its last one.
+// If the node gets empty, nil is returned.
+func (n *trieNode[T]) popLeaf(level uint, position int) *trieNode[T] {
+	index := (position >> level) & trieMask
+	children := append([]*trieNode[T](nil), n.children[:index+1]...)
+	if level > trieBits {
+		if child := n.children[index].popLeaf(level-trieBits, position); child != nil {
+			children[index] = child
+			return &trieNode[T]{children: children}
+		}
+	}
+	if index == 0 {
+		return nil
+	}
+	return &trieNode[T]{children: children[:index]}
+}
+
+// set returns a copy of the node with the given element at the given position.
+func (n *trieNode[T]) set(level uint, position int, element T) *trieNode[T] {
+	if level == 0 {
+		values := append([]T(nil), n.values...)
+		values[position&trieMask] = element
+		return &trieNode[T]{values: values}
+	}
+	index := (position >> level) & trieMask
+	children := append([]*trieNode[T](nil), n.children...)
+	children[index] = children[index].set(level-trieBits, position, element)
+	return &trieNode[T]{children: children}
+}
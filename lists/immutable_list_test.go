@@ -0,0 +1,126 @@
+package lists
+
+import (
+	"encoding/json"
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+func TestImmutableList_Persistence(t *testing.T) {
+	type version struct {
+		list     *ImmutableList[int]
+		expected string
+	}
+	random := rand.New(rand.NewSource(1))
+	list := NewImmutableList[int]()
+	model := NewList[int]()
+	var versions []version
+	for step := 0; step < 5000; step++ {
+		switch operation := random.Intn(10); {
+		case operation < 4:
+			elements := make([]int, random.Intn(40)+1)
+			for i := range elements {
+				elements[i] = step*100 + i
+			}
+			list, model = list.Push(elements...), model.Push(elements...).(*List[int])
+		case operation < 6 && model.IsNotEmpty():
+			list, model = list.Pop(), model.Pop().(*List[int])
+		case operation < 8 && model.IsNotEmpty():
+			list, model = list.Shift(), model.Shift().(*List[int])
+		case model.IsNotEmpty():
+			index := random.Intn(model.Length())
+			list, model = list.Set(index, -step), model.Set(index, -step).(*List[int])
+		}
+		if step%50 == 0 {
+			versions = append(versions, version{list, model.String()})
+		}
+		if list.Length() != model.Length() || (model.IsNotEmpty() && list.LastElement() != model.LastElement()) {
+			t.Fatalf("ImmutableList diverged at step %v. Expected %v. Got: %v", step, model, list)
+		}
+	}
+	for _, v := range versions {
+		if v.list.String() != v.expected {
+			t.Fatalf("ImmutableList versions should stay valid. Expected %v. Got: %v", v.expected, v.list)
+		}
+	}
+}
+
+func TestImmutableList_Unchanged(t *testing.T) {
+	list := NewImmutableList(3, 1, 2)
+	list.Push(4)
+	list.Pop()
+	list.Shift()
+	list.Set(0, 5)
+	list.Sort(ascending)
+	list.Clear()
+	list.InsertAt(0, 6)
+	list.RemoveAt(0)
+	list.RemoveWhere(func(int) bool { return true })
+	*list.At(0) = 7
+	list.Elements()[1] = 8
+	if list.String() != "[3 1 2]" {
+		t.Errorf("ImmutableList should never be changed. Got: %v", list)
+	}
+	if sorted := list.Sort(ascending).InsertAt(1, 0).RemoveAt(3); sorted.String() != "[1 0 2]" {
+		t.Errorf("ImmutableList should return the changed versions. Got: %v", sorted)
+	}
+}
+
+func TestImmutableList_Splice(t *testing.T) {
+	list := NewImmutableList(1, 2, 3, 4)
+	spliced, removed := list.Splice(1, 2, 5)
+	if spliced.String() != "[1 5 4]" || removed.String() != "[2 3]" || list.Length() != 4 {
+		t.Errorf("Splice should return the new version and the removed elements. Got: %v and %v", spliced, removed)
+	}
+}
+
+func TestImmutableList_ToList(t *testing.T) {
+	list := NewImmutableList(1, 2, 3)
+	converted := list.ToList()
+	converted.Push(4)
+	sum := 0
+	list.ForEach(func(v int, i int) {
+		sum += v * i
+	})
+	if converted.Join(",") != "1,2,3,4" || list.Length() != 3 || Sum[int](list.ToList()) != 6 || sum != 8 {
+		t.Errorf("ToList should return a detached List. Got: %v and %v", converted, list)
+	}
+}
+
+func TestImmutableList_Errors(t *testing.T) {
+	var empty ImmutableList[int]
+	if _, err := empty.TryPop(); !errors.Is(err, ErrEmpty) {
+		t.Errorf("TryPop should return ErrEmpty. Got: %v", err)
+	}
+	if _, err := empty.TryShift(); !errors.Is(err, ErrEmpty) {
+		t.Errorf("TryShift should return ErrEmpty. Got: %v", err)
+	}
+	if _, err := empty.Push(1).TrySet(1, 2); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Errorf("TrySet should return ErrIndexOutOfRange. Got: %v", err)
+	}
+	if empty.At(0) != nil || empty.Last() != nil || !empty.IsThreadSafe() {
+		t.Error("The zero ImmutableList should be empty")
+	}
+}
+
+func TestImmutableList_JSON(t *testing.T) {
+	list := NewImmutableList[int]()
+	if err := json.Unmarshal([]byte("[1, 2, 3]"), list); err != nil {
+		t.Fatal(err)
+	}
+	bytes, err := json.Marshal(list)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(bytes) != "[1,2,3]" {
+		t.Errorf("ImmutableList should be marshalled as an array. Got: %s", bytes)
+	}
+}
+
+func BenchmarkImmutableList_Push(b *testing.B) {
+	list := NewImmutableList[int]()
+	for i := 0; i < b.N; i++ {
+		list = list.Push(i)
+	}
+}
@@ -0,0 +1,661 @@
+package lists
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// Node is an element of a LinkedList. It is a stable handle: it keeps referring to the same element while it is moved,
+// and the LinkedList node-level methods (such as InsertAfter, MoveToFront and Remove) run in O(1) with it.
+type Node[T any] struct {
+	// Value is the element held by the Node.
+	Value T
+
+	next, prev *Node[T]
+	list       *LinkedList[T]
+}
+
+// Next returns the next Node in its LinkedList, or nil if it is the last one or was removed.
+func (n *Node[T]) Next() *Node[T] {
+	return n.next
+}
+
+// Prev returns the previous Node in its LinkedList, or nil if it is the first one or was removed.
+func (n *Node[T]) Prev() *Node[T] {
+	return n.prev
+}
+
+// NewLinkedList returns a new LinkedList with the given elements
+func NewLinkedList[T any](elements ...T) *LinkedList[T] {
+	l := &LinkedList[T]{}
+	l.Push(elements...)
+	return l
+}
+
+// NewLinkedListFrom returns a new LinkedList with the given slice
+func NewLinkedListFrom[T any](elements []T) *LinkedList[T] {
+	return NewLinkedList(elements...)
+}
+
+// LinkedList is a doubly linked, dynamically-sized and thread-unsafe implementation of IList.
+// Adding and removing elements at both ends is O(1), and so is any change given a Node (see Front, Back and NodeAt).
+// Accessing an element by its index is O(n), walking from the nearest end. The zero value is an empty LinkedList.
+//
+// Node-level methods do nothing (returning nil, where applicable) when given a Node which does not belong to the LinkedList.
+type LinkedList[T any] struct {
+	head, tail *Node[T]
+	length     int
+}
+
+// Front returns the first Node of the LinkedList, or nil if it is empty.
+func (l *LinkedList[T]) Front() *Node[T] {
+	return l.head
+}
+
+// Back returns the last Node of the LinkedList, or nil if it is empty.
+func (l *LinkedList[T]) Back() *Node[T] {
+	return l.tail
+}
+
+// NodeAt returns the Node at the given index, walking from the nearest end of the LinkedList.
+// If there is no element at the given index, nil will be returned.
+func (l *LinkedList[T]) NodeAt(index int) *Node[T] {
+	if index < 0 || index >= l.length {
+		return nil
+	}
+	if index < l.length/2 {
+		n := l.head
+		for ; index > 0; index-- {
+			n = n.next
+		}
+		return n
+	}
+	n := l.tail
+	for index = l.length - 1 - index; index > 0; index-- {
+		n = n.prev
+	}
+	return n
+}
+
+// PushFront adds the given element at the beginning of the LinkedList, and returns its Node.
+func (l *LinkedList[T]) PushFront(element T) *Node[T] {
+	return l.link(&Node[T]{Value: element}, nil)
+}
+
+// PushBack adds the given element at the end of the LinkedList, and returns its Node.
+func (l *LinkedList[T]) PushBack(element T) *Node[T] {
+	return l.link(&Node[T]{Value: element}, l.tail)
+}
+
+// InsertAfter adds the given element right after the given Node, and returns its Node.
+func (l *LinkedList[T]) InsertAfter(element T, mark *Node[T]) *Node[T] {
+	if mark == nil || mark.list != l {
+		return nil
+	}
+	return l.link(&Node[T]{Value: element}, mark)
+}
+
+// InsertBefore adds the given element right before the given Node, and returns its Node.
+func (l *LinkedList[T]) InsertBefore(element T, mark *Node[T]) *Node[T] {
+	if mark == nil || mark.list != l {
+		return nil
+	}
+	return l.link(&Node[T]{Value: element}, mark.prev)
+}
+
+// Remove removes the given Node from the LinkedList, and returns its element.
+func (l *LinkedList[T]) Remove(node *Node[T]) T {
+	if node == nil {
+		var zero T
+		return zero
+	}
+	if node.list == l {
+		l.unlink(node)
+	}
+	return node.Value
+}
+
+// MoveToFront moves the given Node to the beginning of the LinkedList.
+func (l *LinkedList[T]) MoveToFront(node *Node[T]) {
+	if node != nil && node.list == l && node != l.head {
+		l.unlink(node)
+		l.link(node, nil)
+	}
+}
+
+// MoveToBack moves the given Node to the end of the LinkedList.
+func (l *LinkedList[T]) MoveToBack(node *Node[T]) {
+	if node != nil && node.list == l && node != l.tail {
+		l.unlink(node)
+		l.link(node, l.tail)
+	}
+}
+
+// MoveAfter moves the given Node right after the mark Node.
+func (l *LinkedList[T]) MoveAfter(node, mark *Node[T]) {
+	if node != nil && mark != nil && node.list == l && mark.list == l && node != mark {
+		l.unlink(node)
+		l.link(node, mark)
+	}
+}
+
+// MoveBefore moves the given Node right before the mark Node.
+func (l *LinkedList[T]) MoveBefore(node, mark *Node[T]) {
+	if node != nil && mark != nil && node.list == l && mark.list == l && node != mark {
+		l.unlink(node)
+		l.link(node, mark.prev)
+	}
+}
+
+// Length returns how many elements are in the LinkedList.
+func (l *LinkedList[T]) Length() int {
+	return l.length
+}
+
+// IsEmpty returns true if there are *no* Elements stored in the LinkedList.
+func (l *LinkedList[T]) IsEmpty() bool {
+	return l.length == 0
+}
+
+// IsNotEmpty returns true if there are Elements stored in the LinkedList.
+func (l *LinkedList[T]) IsNotEmpty() bool {
+	return l.length > 0
+}
+
+// At returns the pointer of the element at the given index from the LinkedList.
+// If there is no element at the given index, nil will be returned.
+func (l *LinkedList[T]) At(i int) *T {
+	return l.valueOf(l.NodeAt(i))
+}
+
+// AtFromEnd returns the pointer of the element at the given offset from the end of the LinkedList: AtFromEnd(0) is the last element.
+// If there is no element at the given offset, nil will be returned.
+func (l *LinkedList[T]) AtFromEnd(i int) *T {
+	return l.At(l.length - 1 - i)
+}
+
+// ElementAt returns the element at the given index from the LinkedList.
+// If there is no element at the given index, panics.
+func (l *LinkedList[T]) ElementAt(i int) T {
+	return must(l.TryElementAt(i))
+}
+
+// TryElementAt returns the element at the given index from the LinkedList.
+// If there is no element at the given index, ErrIndexOutOfRange is returned.
+func (l *LinkedList[T]) TryElementAt(i int) (T, error) {
+	n := l.NodeAt(i)
+	if n == nil {
+		var zero T
+		return zero, outOfRange(i, l.length)
+	}
+	return n.Value, nil
+}
+
+// Elements returns a new built-in slice with all elements in the LinkedList.
+func (l *LinkedList[T]) Elements() []T {
+	elements := make([]T, 0, l.length)
+	for n := l.head; n != nil; n = n.next {
+		elements = append(elements, n.Value)
+	}
+	return elements
+}
+
+// Push add the given elements at the end of the LinkedList, and then returns itself.
+func (l *LinkedList[T]) Push(elements ...T) IList[T] {
+	for _, v := range elements {
+		l.PushBack(v)
+	}
+	return l
+}
+
+// Clone returns an identical LinkedList from the original, with new Nodes.
+func (l *LinkedList[T]) Clone() IList[T] {
+	return NewLinkedList(l.Elements()...)
+}
+
+// FirstElement returns the first element in the LinkedList.
+// If LinkedList is empty (see IsEmpty), panics
+func (l *LinkedList[T]) FirstElement() T {
+	return must(l.TryFirstElement())
+}
+
+// TryFirstElement returns the first element in the LinkedList.
+// If LinkedList is empty (see IsEmpty), ErrEmpty is returned.
+func (l *LinkedList[T]) TryFirstElement() (T, error) {
+	if l.IsEmpty() {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return l.head.Value, nil
+}
+
+// First returns the pointer of the first element in the LinkedList.
+// If LinkedList is empty (see IsEmpty), nil will be returned.
+func (l *LinkedList[T]) First() *T {
+	return l.valueOf(l.head)
+}
+
+// LastElement returns the last element in the LinkedList.
+// If LinkedList is empty (see IsEmpty), panics
+func (l *LinkedList[T]) LastElement() T {
+	return must(l.TryLastElement())
+}
+
+// TryLastElement returns the last element in the LinkedList.
+// If LinkedList is empty (see IsEmpty), ErrEmpty is returned.
+func (l *LinkedList[T]) TryLastElement() (T, error) {
+	if l.IsEmpty() {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return l.tail.Value, nil
+}
+
+// Last returns the pointer of the last element in the LinkedList.
+// If LinkedList is empty (see IsEmpty), nil will be returned.
+func (l *LinkedList[T]) Last() *T {
+	return l.valueOf(l.tail)
+}
+
+// FirstIndexWhere returns the index of the first element which satisfies the predicate.
+// If no element satisfies the predicate, -1 will be returned.
+func (l *LinkedList[T]) FirstIndexWhere(handler Predicate[T]) int {
+	i := 0
+	for n := l.head; n != nil; n = n.next {
+		if handler(n.Value) {
+			return i
+		}
+		i++
+	}
+	return -1
+}
+
+// FirstWhere returns the pointer of the first element which satisfies the predicate.
+// If no element satisfies the predicate, nil will be returned.
+func (l *LinkedList[T]) FirstWhere(handler Predicate[T]) *T {
+	for n := l.head; n != nil; n = n.next {
+		if handler(n.Value) {
+			return &n.Value
+		}
+	}
+	return nil
+}
+
+// FirstElementWhere returns the first element which satisfies the predicate.
+// If no element satisfies the predicate, panics.
+func (l *LinkedList[T]) FirstElementWhere(handler Predicate[T]) T {
+	return must(l.TryFirstElementWhere(handler))
+}
+
+// TryFirstElementWhere returns the first element which satisfies the predicate.
+// If no element satisfies the predicate, ErrNotFound is returned.
+func (l *LinkedList[T]) TryFirstElementWhere(handler Predicate[T]) (T, error) {
+	if at := l.FirstWhere(handler); at != nil {
+		return *at, nil
+	}
+	var zero T
+	return zero, ErrNotFound
+}
+
+// LastIndexWhere returns the index of the last element which satisfies the predicate.
+// If no element satisfies the predicate, -1 will be returned.
+func (l *LinkedList[T]) LastIndexWhere(handler Predicate[T]) int {
+	i := l.length - 1
+	for n := l.tail; n != nil; n = n.prev {
+		if handler(n.Value) {
+			return i
+		}
+		i--
+	}
+	return -1
+}
+
+// LastWhere returns the pointer of the last element which satisfies the predicate.
+// If no element satisfies the predicate, nil will be returned.
+func (l *LinkedList[T]) LastWhere(handler Predicate[T]) *T {
+	for n := l.tail; n != nil; n = n.prev {
+		if handler(n.Value) {
+			return &n.Value
+		}
+	}
+	return nil
+}
+
+// LastElementWhere returns the last element which satisfies the predicate.
+// If no element satisfies the predicate, panics.
+func (l *LinkedList[T]) LastElementWhere(handler Predicate[T]) T {
+	return must(l.TryLastElementWhere(handler))
+}
+
+// TryLastElementWhere returns the last element which satisfies the predicate.
+// If no element satisfies the predicate, ErrNotFound is returned.
+func (l *LinkedList[T]) TryLastElementWhere(handler Predicate[T]) (T, error) {
+	if at := l.LastWhere(handler); at != nil {
+		return *at, nil
+	}
+	var zero T
+	return zero, ErrNotFound
+}
+
+// IndexWhere returns a new List with the indexes of all elements which satisfies the predicate.
+func (l *LinkedList[T]) IndexWhere(handler Predicate[T]) IList[int] {
+	return l.list().IndexWhere(handler)
+}
+
+// Where returns a new List with only the elements which satisfies the predicate.
+func (l *LinkedList[T]) Where(handler Predicate[T]) IList[T] {
+	return l.list().Where(handler)
+}
+
+// Map iterates over the elements of the LinkedList calling Mapper, and return a new List with the results.
+func (l *LinkedList[T]) Map(handler Mapper[T]) IList[any] {
+	return l.list().Map(handler)
+}
+
+// Reduce executes the Reducer for each element from the LinkedList with the given accumulator, and each result will be the accumulator for the next.
+// The final result will be returned.
+func (l *LinkedList[T]) Reduce(reducer Reducer[T], accumulator any) any {
+	return l.list().Reduce(reducer, accumulator)
+}
+
+// Every returns true if every element in the LinkedList satisfies the predicate.
+func (l *LinkedList[T]) Every(handler Predicate[T]) bool {
+	return l.FirstIndexWhere(func(v T) bool {
+		return !handler(v)
+	}) == -1
+}
+
+// Some returns true if at least one element in the LinkedList satisfies the predicate.
+func (l *LinkedList[T]) Some(handler Predicate[T]) bool {
+	return l.FirstIndexWhere(handler) != -1
+}
+
+// None returns true if no element in the LinkedList satisfies the predicate.
+func (l *LinkedList[T]) None(handler Predicate[T]) bool {
+	return !l.Some(handler)
+}
+
+// ForEach calls the Consumer with each element of the LinkedList and its index, in order.
+func (l *LinkedList[T]) ForEach(handler Consumer[T]) {
+	l.list().ForEach(handler)
+}
+
+// ForEachUntil calls the IndexedPredicate with each element of the LinkedList and its index, in order, until it returns true.
+func (l *LinkedList[T]) ForEachUntil(handler IndexedPredicate[T]) {
+	l.list().ForEachUntil(handler)
+}
+
+// ForEachContext calls the Consumer with each element of the LinkedList and its index, in order, checking the context before each call.
+// Once the context is done, it stops and returns the context error.
+func (l *LinkedList[T]) ForEachContext(ctx context.Context, handler Consumer[T]) error {
+	return l.list().ForEachContext(ctx, handler)
+}
+
+// Pop removes the last element from the IList and returns itself.
+// If LinkedList is empty (see IsEmpty), panics.
+func (l *LinkedList[T]) Pop() IList[T] {
+	return must(l.TryPop())
+}
+
+// TryPop removes the last element from the IList and returns itself.
+// If LinkedList is empty (see IsEmpty), it is kept unaltered and ErrEmpty is returned.
+func (l *LinkedList[T]) TryPop() (IList[T], error) {
+	if l.IsEmpty() {
+		return l, ErrEmpty
+	}
+	l.unlink(l.tail)
+	return l, nil
+}
+
+// Shift removes the first element from the IList and then returns itself. It runs in O(1).
+// If LinkedList is empty (see IsEmpty), panics.
+func (l *LinkedList[T]) Shift() IList[T] {
+	return must(l.TryShift())
+}
+
+// TryShift removes the first element from the IList and then returns itself.
+// If LinkedList is empty (see IsEmpty), it is kept unaltered and ErrEmpty is returned.
+func (l *LinkedList[T]) TryShift() (IList[T], error) {
+	if l.IsEmpty() {
+		return l, ErrEmpty
+	}
+	l.unlink(l.head)
+	return l, nil
+}
+
+// Set sets the given element at the given index, and then returns itself.
+// If there is no element at the given index, panics.
+func (l *LinkedList[T]) Set(index int, element T) IList[T] {
+	return must(l.TrySet(index, element))
+}
+
+// TrySet sets the given element at the given index, and then returns itself.
+// If there is no element at the given index, it is kept unaltered and ErrIndexOutOfRange is returned.
+func (l *LinkedList[T]) TrySet(index int, element T) (IList[T], error) {
+	n := l.NodeAt(index)
+	if n == nil {
+		return l, outOfRange(index, l.length)
+	}
+	n.Value = element
+	return l, nil
+}
+
+// InsertAt inserts the given elements at the given index, moving the following elements forward, and then returns itself.
+// The index may be equal to Length, to insert at the end. Otherwise, if there is no element at the given index, panics.
+func (l *LinkedList[T]) InsertAt(index int, elements ...T) IList[T] {
+	if index < 0 || index > l.length {
+		panic(outOfRange(index, l.length))
+	}
+	prev := l.tail
+	if index < l.length {
+		prev = l.NodeAt(index).prev
+	}
+	for _, v := range elements {
+		prev = l.link(&Node[T]{Value: v}, prev)
+	}
+	return l
+}
+
+// RemoveAt removes the element at the given index, moving the following elements backward, and then returns itself.
+// If there is no element at the given index, panics.
+func (l *LinkedList[T]) RemoveAt(index int) IList[T] {
+	return l.RemoveRange(index, index)
+}
+
+// RemoveRange removes all elements between the *from* and *to* indexes, and then returns itself.
+// If the interval is not within the LinkedList bounds, panics.
+func (l *LinkedList[T]) RemoveRange(from, to int) IList[T] {
+	l.removeRange(from, to)
+	return l
+}
+
+// Splice removes deleteCount elements starting at the *start* index, inserts the given elements in their place,
+// and returns a new List with the removed elements. deleteCount is limited to the elements available after start.
+// The start index may be equal to Length, to insert at the end. Otherwise, if there is no element at the given index, panics.
+func (l *LinkedList[T]) Splice(start, deleteCount int, elements ...T) IList[T] {
+	if start < 0 || start > l.length {
+		panic(outOfRange(start, l.length))
+	}
+	if deleteCount > l.length-start {
+		deleteCount = l.length - start
+	}
+	if deleteCount < 0 {
+		deleteCount = 0
+	}
+	removed := l.removeRange(start, start+deleteCount-1)
+	l.InsertAt(start, elements...)
+	return removed
+}
+
+// RemoveWhere removes all the elements which satisfies the predicate, and then returns itself.
+func (l *LinkedList[T]) RemoveWhere(handler Predicate[T]) IList[T] {
+	for n := l.head; n != nil; {
+		next := n.next
+		if handler(n.Value) {
+			l.unlink(n)
+		}
+		n = next
+	}
+	return l
+}
+
+// Interval returns a new List with all elements between the *from* and *to* indexes.
+// If the interval is not within the LinkedList bounds, panics.
+func (l *LinkedList[T]) Interval(from, to int) IList[T] {
+	return must(l.TryInterval(from, to))
+}
+
+// TryInterval returns a new List with all elements between the *from* and *to* indexes.
+// If the interval is not within the LinkedList bounds, ErrIndexOutOfRange is returned.
+func (l *LinkedList[T]) TryInterval(from, to int) (IList[T], error) {
+	if err := l.checkInterval(from, to); err != nil {
+		return nil, err
+	}
+	interval := NewList[T]()
+	for n := l.NodeAt(from); from <= to; from++ {
+		interval.Push(n.Value)
+		n = n.next
+	}
+	return interval, nil
+}
+
+// Slice returns a new List with the elements from the *from* index (inclusive) to the *to* index (exclusive), taking every *step* element.
+// Negative indexes are counted from the end of the LinkedList (-1 is the last element), and out-of-range bounds are clamped.
+// A negative step walks backwards, from *from* down to *to*. A zero step panics with ErrZeroStep.
+func (l *LinkedList[T]) Slice(from, to, step int) IList[T] {
+	return l.list().Slice(from, to, step)
+}
+
+// String returns a string representation of the LinkedList.
+func (l *LinkedList[T]) String() string {
+	return l.list().String()
+}
+
+// Join returns the string representation of each element in the IList, separated by the given separator
+func (l *LinkedList[T]) Join(separator string) string {
+	return l.list().Join(separator)
+}
+
+// Sort receives a Sorter function to sort its elements, and returns itself after sorted.
+// Nodes are relinked in the new order, so they keep holding the same elements.
+// Sort is stable: elements considered equal by the Sorter keep their original order.
+func (l *LinkedList[T]) Sort(sorter Sorter[T]) IList[T] {
+	nodes := make([]*Node[T], 0, l.length)
+	for n := l.head; n != nil; n = n.next {
+		nodes = append(nodes, n)
+	}
+	stableSort(nodes, func(a, b *Node[T]) int {
+		return sorter(a.Value, b.Value)
+	})
+	l.head, l.tail, l.length = nil, nil, 0
+	for _, n := range nodes {
+		n.list = nil
+		l.link(n, l.tail)
+	}
+	return l
+}
+
+// Clear removes all elements from the LinkedList, making it empty, and then returns itself.
+// The removed Nodes are detached, so they no longer belong to the LinkedList.
+func (l *LinkedList[T]) Clear() IList[T] {
+	for l.head != nil {
+		l.unlink(l.head)
+	}
+	return l
+}
+
+// IsDynamicallySized returns true, as LinkedList is a dynamically-sized implementation of IList
+func (l *LinkedList[T]) IsDynamicallySized() bool {
+	return true
+}
+
+// IsThreadSafe returns false, as LinkedList is not a thread-safe implementation of IList
+func (l *LinkedList[T]) IsThreadSafe() bool {
+	return false
+}
+
+func (l *LinkedList[T]) UnmarshalJSON(data []byte) error {
+	var elements []T
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return err
+	}
+	l.Clear().Push(elements...)
+	return nil
+}
+
+func (l *LinkedList[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(l.Elements())
+}
+
+// list returns a new List with the elements of the LinkedList.
+func (l *LinkedList[T]) list() *List[T] {
+	return NewListFrom(l.Elements())
+}
+
+// valueOf returns the pointer of the element held by the given Node, or nil if there is no Node.
+func (l *LinkedList[T]) valueOf(n *Node[T]) *T {
+	if n == nil {
+		return nil
+	}
+	return &n.Value
+}
+
+// link inserts the given Node right after prev, or at the beginning if prev is nil, and then returns it.
+func (l *LinkedList[T]) link(n, prev *Node[T]) *Node[T] {
+	n.list, n.prev = l, prev
+	if prev == nil {
+		n.next, l.head = l.head, n
+	} else {
+		n.next, prev.next = prev.next, n
+	}
+	if n.next == nil {
+		l.tail = n
+	} else {
+		n.next.prev = n
+	}
+	l.length++
+	return n
+}
+
+// unlink removes the given Node from the LinkedList, detaching it, and then returns it.
+func (l *LinkedList[T]) unlink(n *Node[T]) *Node[T] {
+	if n.prev == nil {
+		l.head = n.next
+	} else {
+		n.prev.next = n.next
+	}
+	if n.next == nil {
+		l.tail = n.prev
+	} else {
+		n.next.prev = n.prev
+	}
+	n.next, n.prev, n.list = nil, nil, nil
+	l.length--
+	return n
+}
+
+// checkInterval returns ErrIndexOutOfRange if the interval is not within the LinkedList bounds.
+func (l *LinkedList[T]) checkInterval(from, to int) error {
+	if from < 0 || from > to+1 {
+		return outOfRange(from, l.length)
+	}
+	if to >= l.length {
+		return outOfRange(to, l.length)
+	}
+	return nil
+}
+
+// removeRange removes all elements between the *from* and *to* indexes, and returns a new List with them.
+// If the interval is not within the LinkedList bounds, panics.
+func (l *LinkedList[T]) removeRange(from, to int) IList[T] {
+	if err := l.checkInterval(from, to); err != nil {
+		panic(err)
+	}
+	removed := NewList[T]()
+	for n := l.NodeAt(from); from <= to; from++ {
+		next := n.next
+		removed.Push(l.unlink(n).Value)
+		n = next
+	}
+	return removed
+}
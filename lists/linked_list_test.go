@@ -0,0 +1,106 @@
+package lists
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func cloneLinked[T comparable](t listTestCase[T]) listTestCase[T] {
+	return listTestCase[T]{
+		name:              strings.Replace(t.name, "List", "LinkedList", 1),
+		input:             NewLinkedList[any](t.input.Elements()...),
+		parameters:        t.parameters,
+		expectPanic:       t.expectPanic,
+		expected:          t.expected,
+		runnable:          t.runnable,
+		nilTypeComparison: t.nilTypeComparison,
+	}
+}
+
+func runLinked[T comparable](t *testing.T, cases []listTestCase[T]) {
+	for _, v := range cases {
+		caseRunner[T](t, cloneLinked(v))
+	}
+}
+
+func TestLinkedList_Cases(t *testing.T) {
+	runLinked(t, lengthCases)
+	runLinked(t, emptyCases)
+	runLinked(t, atCases)
+	runLinked(t, indexCases)
+	runLinked(t, whereCases)
+	runLinked(t, mapCases)
+	runLinked(t, reduceCases)
+	runLinked(t, everyCases)
+	runLinked(t, someCases)
+	runLinked(t, noneCases)
+	runLinked(t, forEachCases)
+	runLinked(t, popCases)
+	runLinked(t, shiftCases)
+	runLinked(t, setCases)
+	runLinked(t, spliceCases)
+	runLinked(t, stringCases)
+	runLinked(t, intervalCases)
+	runLinked(t, sliceCases)
+	runLinked(t, sortCases)
+	runLinked(t, clearCases)
+	runLinked(t, tryCases)
+}
+
+func TestLinkedList_Nodes(t *testing.T) {
+	list := NewLinkedList[int]()
+	two := list.PushBack(2)
+	one := list.PushFront(1)
+	three := list.InsertAfter(3, two)
+	list.InsertBefore(0, one)
+	if list.Join(",") != "0,1,2,3" || list.Front().Value != 0 || list.Back() != three {
+		t.Errorf("LinkedList should insert around Nodes. Got: %v", list)
+	}
+	list.MoveToFront(three)
+	list.MoveToBack(one)
+	list.MoveAfter(two, three)
+	if list.Join(",") != "3,2,0,1" || list.NodeAt(1) != two || two.Prev() != three || one.Next() != nil {
+		t.Errorf("LinkedList should move Nodes. Got: %v", list)
+	}
+	list.MoveBefore(one, three)
+	if v := list.Remove(two); v != 2 || list.Join(",") != "1,3,0" || list.Length() != 3 {
+		t.Errorf("LinkedList should remove Nodes. Got: %v", list)
+	}
+	other := NewLinkedList(4)
+	if other.InsertAfter(5, one) != nil || other.Remove(one) != 1 || list.Length() != 3 {
+		t.Error("LinkedList should ignore Nodes of other lists")
+	}
+	list.Remove(two)
+	list.MoveToFront(two)
+	if list.Join(",") != "1,3,0" {
+		t.Errorf("LinkedList should ignore removed Nodes. Got: %v", list)
+	}
+}
+
+func TestLinkedList_Sort(t *testing.T) {
+	list := NewLinkedList(3, 1, 2)
+	three := list.Front()
+	list.Sort(ascending)
+	if list.Join(",") != "1,2,3" || list.Back() != three || three.Value != 3 || list.NodeAt(1).Next() != three {
+		t.Errorf("LinkedList should relink Nodes when sorted. Got: %v", list)
+	}
+	list.Clear()
+	if three.Next() != nil || three.Prev() != nil || list.Remove(three) != 3 || list.IsNotEmpty() {
+		t.Error("LinkedList should detach Nodes when cleared")
+	}
+}
+
+func TestLinkedList_JSON(t *testing.T) {
+	var list LinkedList[int]
+	if err := json.Unmarshal([]byte("[1, 2, 3]"), &list); err != nil {
+		t.Fatal(err)
+	}
+	bytes, err := json.Marshal(&list)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(bytes) != "[1,2,3]" || list.Back().Value != 3 {
+		t.Errorf("LinkedList should be marshalled as an array. Got: %s", bytes)
+	}
+}
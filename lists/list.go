@@ -1,6 +1,7 @@
 package lists
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -46,10 +47,28 @@ func (l *List[T]) At(i int) (at *T) {
 	return
 }
 
+// AtFromEnd returns the pointer of the element at the given offset from the end of the List: AtFromEnd(0) is the last element.
+// If there is no element at the given offset, nil will be returned.
+func (l *List[T]) AtFromEnd(i int) *T {
+	if i < 0 {
+		return nil
+	}
+	return l.At(l.Length() - 1 - i)
+}
+
 // ElementAt returns the element at the given index from the List.
 // If there is no element at the given index, panics.
 func (l *List[T]) ElementAt(i int) T {
-	return l.Elements()[i]
+	return must(l.TryElementAt(i))
+}
+
+// TryElementAt returns the element at the given index from the List.
+// If there is no element at the given index, ErrIndexOutOfRange is returned.
+func (l *List[T]) TryElementAt(i int) (element T, err error) {
+	if i < 0 || i >= l.Length() {
+		return element, outOfRange(i, l.Length())
+	}
+	return l.Elements()[i], nil
 }
 
 // Elements returns a built-in slice with all elements in the List.
@@ -71,7 +90,16 @@ func (l *List[T]) Clone() IList[T] {
 // FirstElement returns the first element in the List.
 // If List is empty (see IsEmpty), panics
 func (l *List[T]) FirstElement() T {
-	return l.ElementAt(0)
+	return must(l.TryFirstElement())
+}
+
+// TryFirstElement returns the first element in the List.
+// If List is empty (see IsEmpty), ErrEmpty is returned.
+func (l *List[T]) TryFirstElement() (element T, err error) {
+	if l.IsEmpty() {
+		return element, ErrEmpty
+	}
+	return l.Elements()[0], nil
 }
 
 // First returns the pointer of the first element in the List.
@@ -83,7 +111,16 @@ func (l *List[T]) First() *T {
 // LastElement returns the last element in the List.
 // If List is empty (see IsEmpty), panics.
 func (l *List[T]) LastElement() T {
-	return l.ElementAt(l.Length() - 1)
+	return must(l.TryLastElement())
+}
+
+// TryLastElement returns the last element in the List.
+// If List is empty (see IsEmpty), ErrEmpty is returned.
+func (l *List[T]) TryLastElement() (element T, err error) {
+	if l.IsEmpty() {
+		return element, ErrEmpty
+	}
+	return l.Elements()[l.Length()-1], nil
 }
 
 // Last returns the pointer of the last element in the IList.
@@ -117,12 +154,18 @@ func (l *List[T]) FirstWhere(handler Predicate[T]) *T {
 // FirstElementWhere returns the first element which satisfies the predicate.
 // If no element satisfies the predicate, panics.
 func (l *List[T]) FirstElementWhere(handler Predicate[T]) T {
+	return must(l.TryFirstElementWhere(handler))
+}
+
+// TryFirstElementWhere returns the first element which satisfies the predicate.
+// If no element satisfies the predicate, ErrNotFound is returned.
+func (l *List[T]) TryFirstElementWhere(handler Predicate[T]) (element T, err error) {
 	for _, v := range l.Elements() {
 		if handler(v) {
-			return v
+			return v, nil
 		}
 	}
-	panic("no element satisfies the predicate")
+	return element, ErrNotFound
 }
 
 // LastIndexWhere returns the index of the last element which satisfies the predicate.
@@ -152,18 +195,23 @@ func (l *List[T]) LastWhere(handler Predicate[T]) *T {
 // LastElementWhere returns the last element which satisfies the predicate.
 // If no element satisfies the predicate, panics.
 func (l *List[T]) LastElementWhere(handler Predicate[T]) T {
-	var e T
+	return must(l.TryLastElementWhere(handler))
+}
+
+// TryLastElementWhere returns the last element which satisfies the predicate.
+// If no element satisfies the predicate, ErrNotFound is returned.
+func (l *List[T]) TryLastElementWhere(handler Predicate[T]) (element T, err error) {
 	found := false
 	for _, v := range l.Elements() {
 		if handler(v) {
-			e = v
+			element = v
 			found = true
 		}
 	}
 	if !found {
-		panic("no element satisfies the predicate")
+		return element, ErrNotFound
 	}
-	return e
+	return element, nil
 }
 
 // IndexWhere returns a List[int] for all element index which satisfies the predicate.
@@ -238,29 +286,184 @@ func (l *List[T]) None(handler Predicate[T]) bool {
 	return true
 }
 
+// ForEach calls the Consumer with each element of the IList and its index, in order.
+func (l *List[T]) ForEach(handler Consumer[T]) {
+	for i, v := range l.Elements() {
+		handler(v, i)
+	}
+}
+
+// ForEachUntil calls the IndexedPredicate with each element of the IList and its index, in order, until it returns true.
+func (l *List[T]) ForEachUntil(handler IndexedPredicate[T]) {
+	for i, v := range l.Elements() {
+		if handler(v, i) {
+			return
+		}
+	}
+}
+
+// ForEachContext calls the Consumer with each element of the IList and its index, in order, checking the context before each call.
+// Once the context is done, it stops and returns the context error.
+func (l *List[T]) ForEachContext(ctx context.Context, handler Consumer[T]) error {
+	for i, v := range l.Elements() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		handler(v, i)
+	}
+	return nil
+}
+
 // Pop removes the last element from the IList and returns itself.
+// If List is empty (see IsEmpty), panics.
 func (l *List[T]) Pop() IList[T] {
+	return must(l.TryPop())
+}
+
+// TryPop removes the last element from the IList and returns itself.
+// If List is empty (see IsEmpty), it is kept unaltered and ErrEmpty is returned.
+func (l *List[T]) TryPop() (IList[T], error) {
+	if l.IsEmpty() {
+		return l, ErrEmpty
+	}
 	*l = l.Elements()[0 : l.Length()-1]
-	return l
+	return l, nil
 }
 
 // Shift removes the first element from the IList and then returns itself.
+// If List is empty (see IsEmpty), panics.
 func (l *List[T]) Shift() IList[T] {
+	return must(l.TryShift())
+}
+
+// TryShift removes the first element from the IList and then returns itself.
+// If List is empty (see IsEmpty), it is kept unaltered and ErrEmpty is returned.
+func (l *List[T]) TryShift() (IList[T], error) {
+	if l.IsEmpty() {
+		return l, ErrEmpty
+	}
 	*l = l.Elements()[1:l.Length()]
-	return l
+	return l, nil
 }
 
 // Set sets the given element at the given index, and then returns itself.
+// If there is no element at the given index, panics.
 func (l *List[T]) Set(index int, element T) IList[T] {
-	l.ElementAt(index)
+	return must(l.TrySet(index, element))
+}
+
+// TrySet sets the given element at the given index, and then returns itself.
+// If there is no element at the given index, it is kept unaltered and ErrIndexOutOfRange is returned.
+func (l *List[T]) TrySet(index int, element T) (IList[T], error) {
 	at := l.At(index)
+	if at == nil {
+		return l, outOfRange(index, l.Length())
+	}
 	*at = element
+	return l, nil
+}
+
+// InsertAt inserts the given elements at the given index, moving the following elements forward, and then returns itself.
+// The index may be equal to Length, to insert at the end. Otherwise, if there is no element at the given index, panics.
+func (l *List[T]) InsertAt(index int, elements ...T) IList[T] {
+	length := l.Length()
+	if index < 0 || index > length {
+		panic(outOfRange(index, length))
+	}
+	*l = append(l.Elements(), elements...)
+	copy(l.Elements()[index+len(elements):], l.Elements()[index:length])
+	copy(l.Elements()[index:], elements)
+	return l
+}
+
+// RemoveAt removes the element at the given index, moving the following elements backward, and then returns itself.
+// If there is no element at the given index, panics.
+func (l *List[T]) RemoveAt(index int) IList[T] {
+	return l.RemoveRange(index, index)
+}
+
+// RemoveRange removes all elements between the *from* and *to* indexes, and then returns itself.
+// If the interval is not within the List bounds, panics.
+func (l *List[T]) RemoveRange(from, to int) IList[T] {
+	if err := l.checkInterval(from, to); err != nil {
+		panic(err)
+	}
+	l.truncate(append(l.Elements()[:from], l.Elements()[to+1:]...))
+	return l
+}
+
+// Splice removes deleteCount elements starting at the *start* index, inserts the given elements in their place,
+// and returns a new List with the removed elements. deleteCount is limited to the elements available after start.
+// The start index may be equal to Length, to insert at the end. Otherwise, if there is no element at the given index, panics.
+func (l *List[T]) Splice(start, deleteCount int, elements ...T) IList[T] {
+	length := l.Length()
+	if start < 0 || start > length {
+		panic(outOfRange(start, length))
+	}
+	if deleteCount > length-start {
+		deleteCount = length - start
+	}
+	if deleteCount < 0 {
+		deleteCount = 0
+	}
+	removed := NewList(l.Elements()[start : start+deleteCount]...)
+	l.RemoveRange(start, start+deleteCount-1)
+	l.InsertAt(start, elements...)
+	return removed
+}
+
+// RemoveWhere removes all the elements which satisfies the predicate, and then returns itself.
+func (l *List[T]) RemoveWhere(handler Predicate[T]) IList[T] {
+	kept := l.Elements()[:0]
+	for _, v := range l.Elements() {
+		if !handler(v) {
+			kept = append(kept, v)
+		}
+	}
+	l.truncate(kept)
 	return l
 }
 
 // Interval returns a new List with all elements between the *from* and *to* indexes.
+// If the interval is not within the List bounds, panics.
 func (l *List[T]) Interval(from, to int) IList[T] {
-	return NewList[T](l.Elements()[from : to+1]...)
+	return must(l.TryInterval(from, to))
+}
+
+// TryInterval returns a new List with all elements between the *from* and *to* indexes.
+// If the interval is not within the List bounds, ErrIndexOutOfRange is returned.
+func (l *List[T]) TryInterval(from, to int) (IList[T], error) {
+	if err := l.checkInterval(from, to); err != nil {
+		return nil, err
+	}
+	return NewList[T](l.Elements()[from : to+1]...), nil
+}
+
+// checkInterval returns ErrIndexOutOfRange if the interval is not within the List bounds.
+func (l *List[T]) checkInterval(from, to int) error {
+	if from < 0 || from > to+1 {
+		return outOfRange(from, l.Length())
+	}
+	if to >= l.Length() {
+		return outOfRange(to, l.Length())
+	}
+	return nil
+}
+
+// Slice returns a new List with the elements from the *from* index (inclusive) to the *to* index (exclusive), taking every *step* element.
+// Negative indexes are counted from the end of the List (-1 is the last element), and out-of-range bounds are clamped, so Slice never panics on bounds.
+// A negative step walks backwards, from *from* down to *to*. A zero step panics with ErrZeroStep.
+func (l *List[T]) Slice(from, to, step int) IList[T] {
+	if step == 0 {
+		panic(ErrZeroStep)
+	}
+	length := l.Length()
+	from, to = clampIndex(from, length, step), clampIndex(to, length, step)
+	sliced := NewList[T]()
+	for i := from; (step > 0 && i < to) || (step < 0 && i > to); i += step {
+		sliced.Push(l.Elements()[i])
+	}
+	return sliced
 }
 
 // String returns a string representation of the List.
@@ -284,11 +487,9 @@ func (l *List[T]) Join(separator string) (joined string) {
 }
 
 // Sort receives a Sorter function to sort its elements, and returns itself after sorted.
+// Sort is stable: elements considered equal by the Sorter keep their original order. It runs in O(n log n).
 func (l *List[T]) Sort(sorter Sorter[T]) IList[T] {
-	changed := true
-	for changed {
-		changed = l.sort(sorter)
-	}
+	stableSort(l.Elements(), sorter)
 	return l
 }
 
@@ -308,22 +509,32 @@ func (l *List[T]) IsThreadSafe() bool {
 	return false
 }
 
-func (l *List[T]) sort(sorter Sorter[T]) bool {
-	changed := false
-	for i, _ := range l.Elements() {
-		if i == 0 {
-			continue
-		}
-		a, b := l.ElementAt(i-1), l.ElementAt(i)
-		step := sorter(a, b)
-		if step <= 0 {
-			continue
-		}
-		if step > 0 {
-			l.Set(i, a)
-			l.Set(i-1, b)
-			changed = true
-		}
+// truncate replaces the List elements with the given prefix of its backing array,
+// zeroing the dropped positions so they do not retain memory.
+func (l *List[T]) truncate(kept []T) {
+	dropped := l.Elements()[len(kept):]
+	var zero T
+	for i := range dropped {
+		dropped[i] = zero
+	}
+	*l = kept
+}
+
+// clampIndex resolves a Slice bound: negative indexes are counted from the end, and out-of-range indexes are clamped.
+// Walking forwards, bounds are clamped to [0, length]. Walking backwards, bounds are clamped to [-1, length-1].
+func clampIndex(index, length, step int) int {
+	if index < 0 {
+		index += length
+	}
+	lower, upper := 0, length
+	if step < 0 {
+		lower, upper = -1, length-1
+	}
+	if index < lower {
+		return lower
+	}
+	if index > upper {
+		return upper
 	}
-	return changed
+	return index
 }
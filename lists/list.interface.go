@@ -1,5 +1,7 @@
 package lists
 
+import "context"
+
 // IList is an interface which provides helper methods to easily handle arrays and slices.
 // Each implementation may have specific behaviors. The default implementation is *List
 type IList[T any] interface {
@@ -16,10 +18,18 @@ type IList[T any] interface {
 	// If there is no element at the given index, nil will be returned.
 	At(int) *T
 
+	// AtFromEnd returns the pointer of the element at the given offset from the end of the IList: AtFromEnd(0) is the last element.
+	// If there is no element at the given offset, nil will be returned.
+	AtFromEnd(int) *T
+
 	// ElementAt returns the element at the given index from the IList.
 	// If there is no element at the given index, panics.
 	ElementAt(int) T
 
+	// TryElementAt returns the element at the given index from the IList.
+	// If there is no element at the given index, ErrIndexOutOfRange is returned.
+	TryElementAt(int) (T, error)
+
 	// Elements returns a built-in slice with all elements in the IList.
 	Elements() []T
 
@@ -33,6 +43,10 @@ type IList[T any] interface {
 	// If IList is empty (see IsEmpty), panics
 	FirstElement() T
 
+	// TryFirstElement returns the first element in the IList.
+	// If IList is empty (see IsEmpty), ErrEmpty is returned.
+	TryFirstElement() (T, error)
+
 	// First returns the pointer of the first element in the IList.
 	// If IList is empty (see IsEmpty), nil will be returned.
 	First() *T
@@ -41,6 +55,10 @@ type IList[T any] interface {
 	// If IList is empty (see IsEmpty), panics.
 	LastElement() T
 
+	// TryLastElement returns the last element in the IList.
+	// If IList is empty (see IsEmpty), ErrEmpty is returned.
+	TryLastElement() (T, error)
+
 	// Last returns the pointer of the last element in the IList.
 	// If IList is empty (see IsEmpty), nil will be returned.
 	Last() *T
@@ -57,6 +75,10 @@ type IList[T any] interface {
 	// If no element satisfies the predicate, panics.
 	FirstElementWhere(handler Predicate[T]) T
 
+	// TryFirstElementWhere returns the first element which satisfies the predicate.
+	// If no element satisfies the predicate, ErrNotFound is returned.
+	TryFirstElementWhere(handler Predicate[T]) (T, error)
+
 	// LastIndexWhere returns the index of the last element which satisfies the predicate.
 	// If no element satisfies the predicate, -1 will be returned.
 	LastIndexWhere(handler Predicate[T]) int
@@ -69,6 +91,10 @@ type IList[T any] interface {
 	// If no element satisfies the predicate, panics.
 	LastElementWhere(handler Predicate[T]) T
 
+	// TryLastElementWhere returns the last element which satisfies the predicate.
+	// If no element satisfies the predicate, ErrNotFound is returned.
+	TryLastElementWhere(handler Predicate[T]) (T, error)
+
 	// IndexWhere returns a IList[int] for all element index which satisfies the predicate.
 	// If no element satisfies the predicate, an empty IList will be returned.
 	IndexWhere(handler Predicate[T]) IList[int]
@@ -93,18 +119,74 @@ type IList[T any] interface {
 	// None returns true no element in the IList satisfy the predicate.
 	None(handler Predicate[T]) bool
 
+	// ForEach calls the Consumer with each element of the IList and its index, in order.
+	ForEach(handler Consumer[T])
+
+	// ForEachUntil calls the IndexedPredicate with each element of the IList and its index, in order, until it returns true.
+	ForEachUntil(handler IndexedPredicate[T])
+
+	// ForEachContext calls the Consumer with each element of the IList and its index, in order, checking the context before each call.
+	// Once the context is done, it stops and returns the context error.
+	ForEachContext(ctx context.Context, handler Consumer[T]) error
+
 	// Pop removes the last element from the IList and returns itself.
+	// If IList is empty (see IsEmpty), panics.
 	Pop() IList[T]
 
+	// TryPop removes the last element from the IList and returns itself.
+	// If IList is empty (see IsEmpty), it is kept unaltered and ErrEmpty is returned.
+	TryPop() (IList[T], error)
+
 	// Shift removes the first element from the IList and then returns itself.
+	// If IList is empty (see IsEmpty), panics.
 	Shift() IList[T]
 
+	// TryShift removes the first element from the IList and then returns itself.
+	// If IList is empty (see IsEmpty), it is kept unaltered and ErrEmpty is returned.
+	TryShift() (IList[T], error)
+
 	// Set sets the given element at the given index, and then returns itself.
+	// If there is no element at the given index, panics.
 	Set(index int, element T) IList[T]
 
+	// TrySet sets the given element at the given index, and then returns itself.
+	// If there is no element at the given index, it is kept unaltered and ErrIndexOutOfRange is returned.
+	TrySet(index int, element T) (IList[T], error)
+
+	// InsertAt inserts the given elements at the given index, moving the following elements forward, and then returns itself.
+	// The index may be equal to Length, to insert at the end. Otherwise, if there is no element at the given index, panics.
+	InsertAt(index int, elements ...T) IList[T]
+
+	// RemoveAt removes the element at the given index, moving the following elements backward, and then returns itself.
+	// If there is no element at the given index, panics.
+	RemoveAt(index int) IList[T]
+
+	// RemoveRange removes all elements between the *from* and *to* indexes, and then returns itself.
+	// If the interval is not within the IList bounds, panics.
+	RemoveRange(from, to int) IList[T]
+
+	// Splice removes deleteCount elements starting at the *start* index, inserts the given elements in their place,
+	// and returns a new IList with the removed elements. deleteCount is limited to the elements available after start.
+	// The start index may be equal to Length, to insert at the end. Otherwise, if there is no element at the given index, panics.
+	Splice(start, deleteCount int, elements ...T) IList[T]
+
+	// RemoveWhere removes all the elements which satisfies the predicate, and then returns itself.
+	RemoveWhere(handler Predicate[T]) IList[T]
+
 	// Interval returns a new IList with all elements between the *from* and *to* indexes.
+	// If the interval is not within the IList bounds, panics.
 	Interval(from, to int) IList[T]
 
+	// TryInterval returns a new IList with all elements between the *from* and *to* indexes.
+	// If the interval is not within the IList bounds, ErrIndexOutOfRange is returned.
+	TryInterval(from, to int) (IList[T], error)
+
+	// Slice returns a new IList with the elements from the *from* index (inclusive) to the *to* index (exclusive), taking every *step* element.
+	// Negative indexes are counted from the end of the IList (-1 is the last element), and out-of-range bounds are clamped, so Slice never panics on bounds.
+	// A negative step walks backwards, from *from* down to *to*. A zero step panics with ErrZeroStep.
+	// e.g. Slice(-10, Length(), 1) returns the last 10 elements, and Slice(-1, -Length()-1, -1) returns all elements in reverse order.
+	Slice(from, to, step int) IList[T]
+
 	// String returns a string representation of the IList.
 	String() string
 
@@ -112,6 +194,7 @@ type IList[T any] interface {
 	Join(separator string) string
 
 	// Sort receives a Sorter function to sort its elements, and returns itself after sorted.
+	// Sort is stable: elements considered equal by the Sorter keep their original order.
 	Sort(sorter Sorter[T]) IList[T]
 
 	// Clear removes all elements from the IList, making it empty, and then returns itself.
@@ -1,7 +1,9 @@
 package lists
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 	"sync"
@@ -583,6 +585,79 @@ var noneCases = []listTestCase[bool]{
 	},
 }
 
+var forEachCases = []listTestCase[string]{
+	{
+		name:        "List.ForEach",
+		input:       NewListFrom[any](oneTwoThree),
+		expected:    "0:1,1:2,2:3",
+		expectPanic: false,
+		runnable: func(t *testing.T, list IList[any], parameters []any) string {
+			visited := NewList[string]()
+			list.ForEach(func(v any, i int) {
+				visited.Push(fmt.Sprint(i, ":", v))
+			})
+			return visited.Join(",")
+		},
+	},
+	{
+		name:        "List.ForEach.Push",
+		input:       NewListFrom[any](oneTwoThree),
+		expected:    "1,2,3 6",
+		expectPanic: false,
+		runnable: func(t *testing.T, list IList[any], parameters []any) string {
+			visited := NewList[any]()
+			list.ForEach(func(v any, i int) {
+				list.Push(v)
+				visited.Push(v)
+			})
+			return fmt.Sprint(visited.Join(","), " ", list.Length())
+		},
+	},
+	{
+		name:        "List.ForEachUntil",
+		input:       NewListFrom[any](oneTwoThree),
+		expected:    "1,2",
+		expectPanic: false,
+		runnable: func(t *testing.T, list IList[any], parameters []any) string {
+			visited := NewList[any]()
+			list.ForEachUntil(func(v any, i int) bool {
+				visited.Push(v)
+				return v.(int) == 2
+			})
+			return visited.Join(",")
+		},
+	},
+	{
+		name:        "List.ForEachContext",
+		input:       NewListFrom[any](oneTwoThree),
+		expected:    "1,2,3 <nil>",
+		expectPanic: false,
+		runnable: func(t *testing.T, list IList[any], parameters []any) string {
+			visited := NewList[any]()
+			err := list.ForEachContext(context.Background(), func(v any, i int) {
+				visited.Push(v)
+			})
+			return fmt.Sprint(visited.Join(","), " ", err)
+		},
+	},
+	{
+		name:        "List.ForEachContext.Cancel",
+		input:       NewListFrom[any](oneTwoThree),
+		expected:    "1 context canceled",
+		expectPanic: false,
+		runnable: func(t *testing.T, list IList[any], parameters []any) string {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			visited := NewList[any]()
+			err := list.ForEachContext(ctx, func(v any, i int) {
+				visited.Push(v)
+				cancel()
+			})
+			return fmt.Sprint(visited.Join(","), " ", err)
+		},
+	},
+}
+
 var popCases = []listTestCase[bool]{
 	{
 		name:        "List.Pop",
@@ -656,6 +731,77 @@ var setCases = []listTestCase[bool]{
 	},
 }
 
+var spliceCases = []listTestCase[string]{
+	{
+		name:     "List.InsertAt",
+		input:    NewList[any](1, 2, 3),
+		expected: "[0 1 4 5 2 3 6]",
+		runnable: func(t *testing.T, list IList[any], parameters []any) string {
+			return list.InsertAt(0, 0).InsertAt(2, 4, 5).InsertAt(list.Length(), 6).String()
+		},
+	},
+	{
+		name:        "List.InsertAt.OutOfRange",
+		input:       NewList[any](1, 2, 3),
+		expectPanic: true,
+		runnable: func(t *testing.T, list IList[any], parameters []any) string {
+			return list.InsertAt(4, 4).String()
+		},
+	},
+	{
+		name:     "List.RemoveAt",
+		input:    NewList[any](1, 2, 3, 4),
+		expected: "[2 4]",
+		runnable: func(t *testing.T, list IList[any], parameters []any) string {
+			return list.RemoveAt(0).RemoveAt(1).String()
+		},
+	},
+	{
+		name:        "List.RemoveAt.OutOfRange",
+		input:       NewList[any](1, 2, 3),
+		expectPanic: true,
+		runnable: func(t *testing.T, list IList[any], parameters []any) string {
+			return list.RemoveAt(3).String()
+		},
+	},
+	{
+		name:     "List.RemoveRange",
+		input:    NewList[any](1, 2, 3, 4, 5),
+		expected: "[1 5]",
+		runnable: func(t *testing.T, list IList[any], parameters []any) string {
+			return list.RemoveRange(1, 3).String()
+		},
+	},
+	{
+		name:     "List.Splice",
+		input:    NewList[any](1, 2, 3, 4, 5),
+		expected: "[2 3] [1 6 7 8 4 5]",
+		runnable: func(t *testing.T, list IList[any], parameters []any) string {
+			removed := list.Splice(1, 2, 6, 7, 8)
+			return fmt.Sprint(removed, " ", list)
+		},
+	},
+	{
+		name:     "List.Splice.ClampedCount",
+		input:    NewList[any](1, 2, 3),
+		expected: "[2 3] [1]",
+		runnable: func(t *testing.T, list IList[any], parameters []any) string {
+			removed := list.Splice(1, 10)
+			return fmt.Sprint(removed, " ", list)
+		},
+	},
+	{
+		name:     "List.RemoveWhere",
+		input:    NewList[any](1, 2, 3, 4, 5),
+		expected: "[1 3 5]",
+		runnable: func(t *testing.T, list IList[any], parameters []any) string {
+			return list.RemoveWhere(func(a any) bool {
+				return a.(int)%2 == 0
+			}).String()
+		},
+	},
+}
+
 var stringCases = []listTestCase[string]{
 	{
 		name:        "List.String",
@@ -681,6 +827,65 @@ var intervalCases = []listTestCase[bool]{
 	},
 }
 
+var sliceCases = []listTestCase[string]{
+	{
+		name:     "List.Slice.Tail",
+		input:    NewList[any](1, 2, 3, 4, 5),
+		expected: "[4 5]",
+		runnable: func(t *testing.T, list IList[any], parameters []any) string {
+			return list.Slice(-2, list.Length(), 1).String()
+		},
+	},
+	{
+		name:     "List.Slice.Clamp",
+		input:    NewList[any](1, 2, 3, 4, 5),
+		expected: "[1 2 3 4 5]",
+		runnable: func(t *testing.T, list IList[any], parameters []any) string {
+			return list.Slice(-10, 10, 1).String()
+		},
+	},
+	{
+		name:     "List.Slice.Step",
+		input:    NewList[any](1, 2, 3, 4, 5),
+		expected: "[2 4]",
+		runnable: func(t *testing.T, list IList[any], parameters []any) string {
+			return list.Slice(1, -1, 2).String()
+		},
+	},
+	{
+		name:     "List.Slice.Reverse",
+		input:    NewList[any](1, 2, 3, 4, 5),
+		expected: "[5 4 3 2 1]",
+		runnable: func(t *testing.T, list IList[any], parameters []any) string {
+			return list.Slice(-1, -list.Length()-1, -1).String()
+		},
+	},
+	{
+		name:     "List.Slice.Empty",
+		input:    NewList[any](1, 2, 3, 4, 5),
+		expected: "[]",
+		runnable: func(t *testing.T, list IList[any], parameters []any) string {
+			return list.Slice(3, 1, 1).String()
+		},
+	},
+	{
+		name:        "List.Slice.ZeroStep",
+		input:       NewList[any](1, 2, 3),
+		expectPanic: true,
+		runnable: func(t *testing.T, list IList[any], parameters []any) string {
+			return list.Slice(0, 3, 0).String()
+		},
+	},
+	{
+		name:     "List.AtFromEnd",
+		input:    NewList[any](1, 2, 3),
+		expected: "3 1 true true",
+		runnable: func(t *testing.T, list IList[any], parameters []any) string {
+			return fmt.Sprint(*list.AtFromEnd(0), *list.AtFromEnd(2), list.AtFromEnd(3) == nil, list.AtFromEnd(-1) == nil)
+		},
+	},
+}
+
 var sortCases = []listTestCase[string]{
 	{
 		name:        "List.Sort.Int.Esc",
@@ -706,6 +911,38 @@ var sortCases = []listTestCase[string]{
 			return list.String()
 		},
 	},
+	{
+		name:        "List.Sort.Stable",
+		input:       NewList[any]("b1", "a1", "c1", "b2", "a2", "c2", "b3", "a3"),
+		expected:    fmt.Sprint([]any{"a1", "a2", "a3", "b1", "b2", "b3", "c1", "c2"}),
+		expectPanic: false,
+		runnable: func(t *testing.T, list IList[any], parameters []any) string {
+			list.Sort(func(a, b any) int {
+				return int(a.(string)[0]) - int(b.(string)[0])
+			})
+			return list.String()
+		},
+	},
+	{
+		name:        "List.Sort.Large",
+		input:       NewList[any](),
+		expected:    "true",
+		expectPanic: false,
+		runnable: func(t *testing.T, list IList[any], parameters []any) string {
+			for i := 0; i < 1000; i++ {
+				list.Push((i * 7919) % 1000)
+			}
+			list.Sort(func(a, b any) int {
+				return a.(int) - b.(int)
+			})
+			for i := 0; i < 1000; i++ {
+				if list.ElementAt(i) != i {
+					return "false"
+				}
+			}
+			return "true"
+		},
+	},
 }
 
 var clearCases = []listTestCase[bool]{
@@ -790,6 +1027,113 @@ var specificCases = []listTestCase[bool]{
 	},
 }
 
+var tryCases = []listTestCase[bool]{
+	{
+		name:     "List.TryElementAt.OutOfRange",
+		input:    NewListFrom[any](oneTwoThree),
+		expected: true,
+		runnable: func(t *testing.T, list IList[any], parameters []any) bool {
+			_, negative := list.TryElementAt(-1)
+			_, over := list.TryElementAt(3)
+			element, err := list.TryElementAt(2)
+			return errors.Is(negative, ErrIndexOutOfRange) && errors.Is(over, ErrIndexOutOfRange) && err == nil && element == 3
+		},
+	},
+	{
+		name:     "List.TryFirstElement.Empty",
+		input:    NewList[any](empty...),
+		expected: true,
+		runnable: func(t *testing.T, list IList[any], parameters []any) bool {
+			_, first := list.TryFirstElement()
+			_, last := list.TryLastElement()
+			return errors.Is(first, ErrEmpty) && errors.Is(last, ErrEmpty)
+		},
+	},
+	{
+		name:     "List.TryLastElement.Filled",
+		input:    NewListFrom[any](oneTwoThree),
+		expected: true,
+		runnable: func(t *testing.T, list IList[any], parameters []any) bool {
+			first, firstErr := list.TryFirstElement()
+			last, lastErr := list.TryLastElement()
+			return first == 1 && last == 3 && firstErr == nil && lastErr == nil
+		},
+	},
+	{
+		name:     "List.TryElementWhere.NotFound",
+		input:    NewListFrom[any](oneTwoThree),
+		expected: true,
+		runnable: func(t *testing.T, list IList[any], parameters []any) bool {
+			greater := func(a any) bool {
+				return a.(int) > 3
+			}
+			_, first := list.TryFirstElementWhere(greater)
+			_, last := list.TryLastElementWhere(greater)
+			return errors.Is(first, ErrNotFound) && errors.Is(last, ErrNotFound)
+		},
+	},
+	{
+		name:     "List.TryElementWhere.Found",
+		input:    NewListFrom[any](oneTwoThree),
+		expected: true,
+		runnable: func(t *testing.T, list IList[any], parameters []any) bool {
+			odd := func(a any) bool {
+				return a.(int)%2 == 1
+			}
+			first, _ := list.TryFirstElementWhere(odd)
+			last, err := list.TryLastElementWhere(odd)
+			return first == 1 && last == 3 && err == nil
+		},
+	},
+	{
+		name:     "List.TryPop.Empty",
+		input:    NewList[any](empty...),
+		expected: true,
+		runnable: func(t *testing.T, list IList[any], parameters []any) bool {
+			_, pop := list.TryPop()
+			_, shift := list.TryShift()
+			return errors.Is(pop, ErrEmpty) && errors.Is(shift, ErrEmpty) && list.IsEmpty()
+		},
+	},
+	{
+		name:     "List.TrySet.OutOfRange",
+		input:    NewListFrom[any](oneTwoThree),
+		expected: true,
+		runnable: func(t *testing.T, list IList[any], parameters []any) bool {
+			_, err := list.TrySet(3, 4)
+			set, ok := list.TrySet(0, 4)
+			return errors.Is(err, ErrIndexOutOfRange) && ok == nil && set.Join(",") == "4,2,3"
+		},
+	},
+	{
+		name:     "List.TryInterval.OutOfRange",
+		input:    NewListFrom[any](oneTwoThree),
+		expected: true,
+		runnable: func(t *testing.T, list IList[any], parameters []any) bool {
+			_, over := list.TryInterval(1, 3)
+			_, negative := list.TryInterval(-1, 1)
+			interval, err := list.TryInterval(1, 2)
+			return errors.Is(over, ErrIndexOutOfRange) && errors.Is(negative, ErrIndexOutOfRange) && err == nil && interval.Join(",") == "2,3"
+		},
+	},
+	{
+		name:        "List.FirstElementWhere.PanicsWithErrNotFound",
+		input:       NewListFrom[any](oneTwoThree),
+		expected:    true,
+		expectPanic: false,
+		runnable: func(t *testing.T, list IList[any], parameters []any) (found bool) {
+			defer func() {
+				err, _ := recover().(error)
+				found = errors.Is(err, ErrNotFound)
+			}()
+			list.FirstElementWhere(func(a any) bool {
+				return false
+			})
+			return
+		},
+	},
+}
+
 var encodeCases = []listTestCase[string]{
 	{
 		name:        "List.MarshallJSON",
@@ -932,6 +1276,46 @@ func TestNone(t *testing.T) {
 	}
 }
 
+func TestForEach(t *testing.T) {
+	for _, v := range forEachCases {
+		safe := cloneSafe(v)
+		caseRunner[string](t, v)
+		caseRunner[string](t, safe)
+	}
+}
+
+func TestForEach_Implementations(t *testing.T) {
+	implementations := []IList[int]{
+		NewList(1, 2, 3),
+		NewSafeList(1, 2, 3),
+		NewFixedList(5, 1, 2, 3),
+		NewSafeFixedList(5, 1, 2, 3),
+		NewCopyOnWriteList(1, 2, 3),
+		NewSortedList(ascending, 3, 1, 2),
+		NewObservableList[int](NewSafeList(1, 2, 3)),
+		NewLinkedList(1, 2, 3),
+	}
+	for _, list := range implementations {
+		sum := 0
+		list.ForEach(func(v int, i int) {
+			sum += v * i
+		})
+		visited := NewList[int]()
+		list.ForEachUntil(func(v int, i int) bool {
+			visited.Push(v)
+			return i == 1
+		})
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		err := list.ForEachContext(ctx, func(v int, i int) {
+			t.Errorf("%T.ForEachContext should not call the Consumer once cancelled", list)
+		})
+		if sum != 8 || visited.Join(",") != "1,2" || !errors.Is(err, context.Canceled) {
+			t.Errorf("%T.ForEach: expected 8, 1,2 and context.Canceled. Got: %v, %v and %v", list, sum, visited, err)
+		}
+	}
+}
+
 func TestPop(t *testing.T) {
 	for _, v := range popCases {
 		safe := cloneSafe(v)
@@ -948,6 +1332,14 @@ func TestShift(t *testing.T) {
 	}
 }
 
+func TestSplice(t *testing.T) {
+	for _, v := range spliceCases {
+		safe := cloneSafe(v)
+		caseRunner[string](t, v)
+		caseRunner[string](t, safe)
+	}
+}
+
 func TestString(t *testing.T) {
 	for _, v := range stringCases {
 		safe := cloneSafe(v)
@@ -972,6 +1364,14 @@ func TestInterval(t *testing.T) {
 	}
 }
 
+func TestSlice(t *testing.T) {
+	for _, v := range sliceCases {
+		safe := cloneSafe(v)
+		caseRunner[string](t, v)
+		caseRunner[string](t, safe)
+	}
+}
+
 func TestSort(t *testing.T) {
 	for _, v := range sortCases {
 		safe := cloneSafe(v)
@@ -988,6 +1388,14 @@ func TestClear(t *testing.T) {
 	}
 }
 
+func TestTry(t *testing.T) {
+	for _, v := range tryCases {
+		safe := cloneSafe(v)
+		caseRunner[bool](t, v)
+		caseRunner[bool](t, safe)
+	}
+}
+
 func TestEncode(t *testing.T) {
 	for _, v := range encodeCases {
 		safe := cloneSafe(v)
@@ -0,0 +1,193 @@
+package lists
+
+import (
+	"math"
+)
+
+// Signed is a constraint for signed integer types.
+type Signed interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64
+}
+
+// Unsigned is a constraint for unsigned integer types.
+type Unsigned interface {
+	~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Integer is a constraint for integer types.
+type Integer interface {
+	Signed | Unsigned
+}
+
+// Float is a constraint for floating-point types.
+type Float interface {
+	~float32 | ~float64
+}
+
+// Number is a constraint for integer and floating-point types.
+type Number interface {
+	Integer | Float
+}
+
+// Ordered is a constraint for types which support the < operator.
+type Ordered interface {
+	Integer | Float | ~string
+}
+
+// Sum returns the sum of the elements of the given IList, or 0 if it is empty.
+// Integer sums wrap around on overflow, as Go arithmetic does. See CheckedSum.
+func Sum[T Number](list IList[T]) T {
+	var sum T
+	for _, v := range elementsOf(list) {
+		sum += v
+	}
+	return sum
+}
+
+// CheckedSum returns the sum of the elements of the given IList, or 0 if it is empty.
+// If the sum overflows T, ErrOverflow is returned.
+func CheckedSum[T Integer](list IList[T]) (T, error) {
+	var sum T
+	for _, v := range elementsOf(list) {
+		next := sum + v
+		if (v > 0 && next < sum) || (v < 0 && next > sum) {
+			return sum, ErrOverflow
+		}
+		sum = next
+	}
+	return sum, nil
+}
+
+// Product returns the product of the elements of the given IList, or 1 if it is empty.
+func Product[T Number](list IList[T]) T {
+	var product T = 1
+	for _, v := range elementsOf(list) {
+		product *= v
+	}
+	return product
+}
+
+// Min returns the smallest element of the given IList. NaN is smaller than any other value, as in Ascending.
+// If the IList is empty, ErrEmpty is returned.
+func Min[T Ordered](list IList[T]) (T, error) {
+	return MinBy(list, identity[T])
+}
+
+// Max returns the greatest element of the given IList. NaN is smaller than any other value, as in Ascending.
+// If the IList is empty, ErrEmpty is returned.
+func Max[T Ordered](list IList[T]) (T, error) {
+	return MaxBy(list, identity[T])
+}
+
+// MinBy returns the element of the given IList with the smallest key. If many elements share it, the first one is returned.
+// Keys are compared as Ascending does, so a NaN key is the smallest one.
+// If the IList is empty, ErrEmpty is returned.
+func MinBy[T any, K Ordered](list IList[T], key TypeMapper[T, K]) (T, error) {
+	return extremeBy(elementsOf(list), key, func(a, b K) bool {
+		return compare(a, b) < 0
+	})
+}
+
+// MaxBy returns the element of the given IList with the greatest key. If many elements share it, the first one is returned.
+// Keys are compared as Ascending does, so a NaN key is the greatest one only if every key is NaN.
+// If the IList is empty, ErrEmpty is returned.
+func MaxBy[T any, K Ordered](list IList[T], key TypeMapper[T, K]) (T, error) {
+	return extremeBy(elementsOf(list), key, func(a, b K) bool {
+		return compare(a, b) > 0
+	})
+}
+
+// Average returns the arithmetic mean of the elements of the given IList. It is summed as float64, so it does not overflow.
+// If the IList is empty, ErrEmpty is returned.
+func Average[T Number](list IList[T]) (float64, error) {
+	elements := elementsOf(list)
+	if len(elements) == 0 {
+		return 0, ErrEmpty
+	}
+	var sum float64
+	for _, v := range elements {
+		sum += float64(v)
+	}
+	return sum / float64(len(elements)), nil
+}
+
+// Median returns the middle element of the given IList, once sorted, or the mean of the two middle ones if its Length is even.
+// If the IList is empty, ErrEmpty is returned.
+func Median[T Number](list IList[T]) (float64, error) {
+	return Percentile(list, 50)
+}
+
+// Mode returns the most frequent element of the given IList. If many elements are equally frequent, the first one is returned.
+// If the IList is empty, ErrEmpty is returned.
+func Mode[T comparable](list IList[T]) (T, error) {
+	elements := elementsOf(list)
+	counts := make(map[T]int, len(elements))
+	for _, v := range elements {
+		counts[v]++
+	}
+	return extremeBy(elements, func(v T) int {
+		return counts[v]
+	}, func(a, b int) bool {
+		return a > b
+	})
+}
+
+// Variance returns the population variance of the elements of the given IList: the mean of the squared distances from their mean.
+// If the IList is empty, ErrEmpty is returned.
+func Variance[T Number](list IList[T]) (float64, error) {
+	elements := elementsOf(list)
+	if len(elements) == 0 {
+		return 0, ErrEmpty
+	}
+	// Welford's algorithm, which is numerically stable.
+	var mean, squares float64
+	for i, v := range elements {
+		delta := float64(v) - mean
+		mean += delta / float64(i+1)
+		squares += delta * (float64(v) - mean)
+	}
+	return squares / float64(len(elements)), nil
+}
+
+// StdDev returns the population standard deviation of the elements of the given IList: the square root of their Variance.
+// If the IList is empty, ErrEmpty is returned.
+func StdDev[T Number](list IList[T]) (float64, error) {
+	variance, err := Variance(list)
+	return math.Sqrt(variance), err
+}
+
+// Percentile returns the value below which the given percentage (from 0 to 100) of the elements of the given IList fall.
+// It interpolates linearly between the two closest elements, once sorted: Percentile(list, 50) is the Median.
+// If the IList is empty, ErrEmpty is returned. If p is not between 0 and 100, ErrInvalidPercentile is returned.
+func Percentile[T Number](list IList[T], p float64) (float64, error) {
+	if !(p >= 0 && p <= 100) {
+		return 0, ErrInvalidPercentile
+	}
+	elements := append([]T(nil), elementsOf(list)...)
+	if len(elements) == 0 {
+		return 0, ErrEmpty
+	}
+	stableSort(elements, Ascending[T]())
+	rank := p / 100 * float64(len(elements)-1)
+	lower := int(rank)
+	if lower == len(elements)-1 {
+		return float64(elements[lower]), nil
+	}
+	fraction := rank - float64(lower)
+	return float64(elements[lower]) + fraction*(float64(elements[lower+1])-float64(elements[lower])), nil
+}
+
+// extremeBy returns the first element whose key is preferred over all the others, or ErrEmpty if there are no elements.
+func extremeBy[T any, K Ordered](elements []T, key TypeMapper[T, K], prefer func(K, K) bool) (T, error) {
+	if len(elements) == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
+	extreme, extremeKey := elements[0], key(elements[0])
+	for _, v := range elements[1:] {
+		if k := key(v); prefer(k, extremeKey) {
+			extreme, extremeKey = v, k
+		}
+	}
+	return extreme, nil
+}
@@ -0,0 +1,129 @@
+package lists
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestSumAndProduct(t *testing.T) {
+	if sum := Sum[float64](NewList(1.5, 2.5, 3)); sum != 7 {
+		t.Errorf("Sum should add every element. Got: %v", sum)
+	}
+	if sum := Sum[int](NewSafeList[int]()); sum != 0 {
+		t.Errorf("Sum of an empty list should be 0. Got: %v", sum)
+	}
+	if product := Product[int](NewList(2, 3, 4)); product != 24 {
+		t.Errorf("Product should multiply every element. Got: %v", product)
+	}
+	if product := Product[int](NewList[int]()); product != 1 {
+		t.Errorf("Product of an empty list should be 1. Got: %v", product)
+	}
+}
+
+func TestCheckedSum(t *testing.T) {
+	if sum, err := CheckedSum[int8](NewList[int8](100, 27, -50)); sum != 77 || err != nil {
+		t.Errorf("CheckedSum should add every element. Got: %v, %v", sum, err)
+	}
+	if _, err := CheckedSum[int8](NewList[int8](100, 28)); !errors.Is(err, ErrOverflow) {
+		t.Errorf("CheckedSum should detect positive overflow. Got: %v", err)
+	}
+	if _, err := CheckedSum[int8](NewList[int8](-100, -29)); !errors.Is(err, ErrOverflow) {
+		t.Errorf("CheckedSum should detect negative overflow. Got: %v", err)
+	}
+	if _, err := CheckedSum[uint8](NewList[uint8](200, 56)); !errors.Is(err, ErrOverflow) {
+		t.Errorf("CheckedSum should detect unsigned overflow. Got: %v", err)
+	}
+	if sum, err := CheckedSum[uint8](NewList[uint8](200, 55)); sum != 255 || err != nil {
+		t.Errorf("CheckedSum should add up to the maximum value. Got: %v, %v", sum, err)
+	}
+}
+
+func TestMinAndMax(t *testing.T) {
+	list := NewList(3, -1, 4, -1, 5)
+	min, minErr := Min[int](list)
+	max, maxErr := Max[int](list)
+	if min != -1 || max != 5 || minErr != nil || maxErr != nil {
+		t.Errorf("Min and Max should find the extremes. Got: %v and %v", min, max)
+	}
+	words := NewList("pear", "fig", "banana", "kiwi")
+	shortest, _ := MinBy[string, int](words, func(v string) int { return len(v) })
+	longest, _ := MaxBy[string, int](words, func(v string) int { return len(v) })
+	first, _ := MaxBy[string, string](words, strings.ToUpper)
+	if shortest != "fig" || longest != "banana" || first != "pear" {
+		t.Errorf("MinBy and MaxBy should compare keys. Got: %v, %v and %v", shortest, longest, first)
+	}
+	for _, list := range []IList[float64]{NewList(math.NaN(), 1, 2), NewList(1, math.NaN(), 2), NewList(1, 2, math.NaN())} {
+		min, _ := Min[float64](list)
+		max, _ := Max[float64](list)
+		if !math.IsNaN(min) || max != 2 {
+			t.Errorf("Min and Max should order NaN first, regardless of its position. Got: %v and %v for %v", min, max, list)
+		}
+	}
+	if max, _ := Max[float64](NewList(math.NaN(), math.NaN())); !math.IsNaN(max) {
+		t.Errorf("Max should be NaN when every element is NaN. Got: %v", max)
+	}
+	if _, err := Min[string](NewList[string]()); !errors.Is(err, ErrEmpty) {
+		t.Errorf("Min of an empty list should return ErrEmpty. Got: %v", err)
+	}
+	if _, err := MaxBy[int, int](NewList[int](), identity[int]); !errors.Is(err, ErrEmpty) {
+		t.Errorf("MaxBy of an empty list should return ErrEmpty. Got: %v", err)
+	}
+}
+
+func TestStatistics(t *testing.T) {
+	list := NewList(2, 4, 4, 4, 5, 5, 7, 9)
+	average, _ := Average[int](list)
+	median, _ := Median[int](list)
+	mode, _ := Mode[int](list)
+	variance, _ := Variance[int](list)
+	deviation, _ := StdDev[int](list)
+	if average != 5 || median != 4.5 || mode != 4 || variance != 4 || deviation != 2 {
+		t.Errorf("Statistics should be computed. Got: %v, %v, %v, %v and %v", average, median, mode, variance, deviation)
+	}
+	if median, _ := Median[float64](NewList(3.0, 1, 2)); median != 2 {
+		t.Errorf("Median of an odd list should be its middle element. Got: %v", median)
+	}
+	if mode, _ := Mode[string](NewList("b", "a", "a", "b")); mode != "b" {
+		t.Errorf("Mode should return the first of the most frequent elements. Got: %v", mode)
+	}
+	if average, _ := Average[int64](NewList[int64](math.MaxInt64, math.MaxInt64)); average != math.MaxInt64 {
+		t.Errorf("Average should not overflow. Got: %v", average)
+	}
+	empty := NewList[int]()
+	for name, aggregate := range map[string]func(IList[int]) (float64, error){
+		"Average":  Average[int],
+		"Median":   Median[int],
+		"Variance": Variance[int],
+		"StdDev":   StdDev[int],
+	} {
+		if _, err := aggregate(empty); !errors.Is(err, ErrEmpty) {
+			t.Errorf("%v of an empty list should return ErrEmpty. Got: %v", name, err)
+		}
+	}
+	if _, err := Mode[int](empty); !errors.Is(err, ErrEmpty) {
+		t.Errorf("Mode of an empty list should return ErrEmpty. Got: %v", err)
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	list := NewSafeList(40, 10, 30, 20, 50)
+	tests := map[float64]float64{0: 10, 25: 20, 50: 30, 90: 46, 100: 50}
+	for p, expected := range tests {
+		if percentile, err := Percentile[int](list, p); percentile != expected || err != nil {
+			t.Errorf("Percentile(%v): expected %v. Got: %v, %v", p, expected, percentile, err)
+		}
+	}
+	if list.Join(",") != "40,10,30,20,50" {
+		t.Errorf("Percentile should not sort the given list. Got: %v", list)
+	}
+	for _, p := range []float64{-1, 101, math.NaN()} {
+		if _, err := Percentile[int](list, p); !errors.Is(err, ErrInvalidPercentile) {
+			t.Errorf("Percentile(%v) should return ErrInvalidPercentile. Got: %v", p, err)
+		}
+	}
+	if _, err := Percentile[int](NewList[int](), 50); !errors.Is(err, ErrEmpty) {
+		t.Errorf("Percentile of an empty list should return ErrEmpty. Got: %v", err)
+	}
+}
@@ -0,0 +1,571 @@
+package lists
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+)
+
+// EventKind identifies the change described by an Event.
+type EventKind int
+
+const (
+	// EventPushed is emitted by Push, with the pushed elements.
+	EventPushed EventKind = iota
+
+	// EventSet is emitted by Set and TrySet, with the new element and the replaced one.
+	EventSet
+
+	// EventPopped is emitted by Pop and TryPop, with the removed element.
+	EventPopped
+
+	// EventShifted is emitted by Shift and TryShift, with the removed element.
+	EventShifted
+
+	// EventSorted is emitted by Sort.
+	EventSorted
+
+	// EventCleared is emitted by Clear, with the removed elements.
+	EventCleared
+
+	// EventInserted is emitted by InsertAt and Splice, with the inserted elements.
+	EventInserted
+
+	// EventRemoved is emitted by RemoveAt, RemoveRange, RemoveWhere and Splice, with the removed elements.
+	EventRemoved
+)
+
+// Event describes a change on an ObservableList.
+type Event[T any] struct {
+	// Kind identifies the change.
+	Kind EventKind
+
+	// Index is the index of the first affected element, or -1 if the affected elements were not contiguous (see RemoveWhere).
+	Index int
+
+	// Elements are the elements added to the ObservableList, if any.
+	Elements []T
+
+	// Removed are the elements removed from (or replaced in) the ObservableList, if any.
+	Removed []T
+}
+
+// Listener receives the Events emitted by an ObservableList.
+type Listener[T any] func(Event[T])
+
+// NewObservableList returns a new ObservableList wrapping the given IList.
+// If the IList may not store the pushed elements as given (see ObservableList), panics with ErrNotObservable.
+func NewObservableList[T any](list IList[T]) *ObservableList[T] {
+	switch list.(type) {
+	case *SortedList[T], interface{ Capacity() int }:
+		panic(ErrNotObservable)
+	}
+	return &ObservableList[T]{l: list}
+}
+
+// ObservableList is an implementation of IList which wraps another IList, emitting an Event to its listeners for each change.
+// Listeners are registered with OnChange, which delivers Events synchronously, or OnChangeAsync.
+//
+// Changes made through the ObservableList are serialized, and their Events are emitted in the order the changes happened,
+// so it is as thread-safe as the wrapped IList (see IsThreadSafe). Changes made directly on the wrapped IList,
+// or through pointers (see At, First, Last, ...), emit no Event.
+//
+// Events describe the changes as requested, so the wrapped IList must apply them as given. SortedList (which moves pushed elements)
+// and fixed-capacity lists such as FixedList (which may discard or evict them) can not be wrapped: see NewObservableList.
+type ObservableList[T any] struct {
+	l         IList[T]
+	mu        sync.Mutex
+	listeners []*listener[T]
+}
+
+// listener holds a registered Listener. Asynchronous ones have their own queue, delivered by their own goroutine.
+type listener[T any] struct {
+	handle Listener[T]
+	queue  *subscriber[Event[T]]
+	done   chan struct{}
+}
+
+// OnChange registers a Listener, which is called synchronously for each Event, in order, before the change returns.
+// It is called while the ObservableList is locked, so it must not change the same ObservableList (see OnChangeAsync).
+// The returned function unregisters the Listener. It may be called more than once.
+func (o *ObservableList[T]) OnChange(handler Listener[T]) func() {
+	return o.register(&listener[T]{handle: handler})
+}
+
+// OnChangeAsync registers a Listener, which is called for each Event, in order, from its own goroutine.
+// Changes never wait for asynchronous Listeners: Events are queued until delivered, so a Listener may change the ObservableList.
+// The returned function unregisters the Listener, which still receives the Events emitted before it, and then its goroutine exits.
+// It may be called more than once.
+func (o *ObservableList[T]) OnChangeAsync(handler Listener[T]) func() {
+	l := &listener[T]{handle: handler, queue: newSubscriber[Event[T]](), done: make(chan struct{})}
+	go l.deliver()
+	return o.register(l)
+}
+
+// Length returns how many elements are in the ObservableList.
+func (o *ObservableList[T]) Length() int {
+	return o.l.Length()
+}
+
+// IsEmpty returns true if there are *no* Elements stored in the ObservableList.
+func (o *ObservableList[T]) IsEmpty() bool {
+	return o.l.IsEmpty()
+}
+
+// IsNotEmpty returns true if there are Elements stored in the ObservableList.
+func (o *ObservableList[T]) IsNotEmpty() bool {
+	return o.l.IsNotEmpty()
+}
+
+// At returns the pointer of the element at the given index from the ObservableList.
+// If there is no element at the given index, nil will be returned.
+func (o *ObservableList[T]) At(i int) *T {
+	return o.l.At(i)
+}
+
+// AtFromEnd returns the pointer of the element at the given offset from the end of the ObservableList: AtFromEnd(0) is the last element.
+// If there is no element at the given offset, nil will be returned.
+func (o *ObservableList[T]) AtFromEnd(i int) *T {
+	return o.l.AtFromEnd(i)
+}
+
+// ElementAt returns the element at the given index from the ObservableList.
+// If there is no element at the given index, panics.
+func (o *ObservableList[T]) ElementAt(i int) T {
+	return o.l.ElementAt(i)
+}
+
+// TryElementAt returns the element at the given index from the ObservableList.
+// If there is no element at the given index, ErrIndexOutOfRange is returned.
+func (o *ObservableList[T]) TryElementAt(i int) (T, error) {
+	return o.l.TryElementAt(i)
+}
+
+// Elements returns a built-in slice with all elements in the ObservableList.
+func (o *ObservableList[T]) Elements() []T {
+	return o.l.Elements()
+}
+
+// Push add the given elements in the ObservableList, and then returns itself.
+// It emits EventPushed, whose Index is the Length before the push.
+func (o *ObservableList[T]) Push(elements ...T) IList[T] {
+	return o.change(func() {
+		index := o.l.Length()
+		o.l.Push(elements...)
+		o.emit(Event[T]{Kind: EventPushed, Index: index, Elements: copyOf(elements)})
+	})
+}
+
+// Clone returns a new ObservableList wrapping a clone of the wrapped IList (see IList.Clone).
+// Listeners are not cloned.
+func (o *ObservableList[T]) Clone() IList[T] {
+	return NewObservableList(o.l.Clone())
+}
+
+// FirstElement returns the first element in the ObservableList.
+// If ObservableList is empty (see IsEmpty), panics
+func (o *ObservableList[T]) FirstElement() T {
+	return o.l.FirstElement()
+}
+
+// TryFirstElement returns the first element in the ObservableList.
+// If ObservableList is empty (see IsEmpty), ErrEmpty is returned.
+func (o *ObservableList[T]) TryFirstElement() (T, error) {
+	return o.l.TryFirstElement()
+}
+
+// First returns the pointer of the first element in the ObservableList.
+// If ObservableList is empty (see IsEmpty), nil will be returned.
+func (o *ObservableList[T]) First() *T {
+	return o.l.First()
+}
+
+// LastElement returns the last element in the ObservableList.
+// If ObservableList is empty (see IsEmpty), panics.
+func (o *ObservableList[T]) LastElement() T {
+	return o.l.LastElement()
+}
+
+// TryLastElement returns the last element in the ObservableList.
+// If ObservableList is empty (see IsEmpty), ErrEmpty is returned.
+func (o *ObservableList[T]) TryLastElement() (T, error) {
+	return o.l.TryLastElement()
+}
+
+// Last returns the pointer of the last element in the ObservableList.
+// If ObservableList is empty (see IsEmpty), nil will be returned.
+func (o *ObservableList[T]) Last() *T {
+	return o.l.Last()
+}
+
+// FirstIndexWhere returns the index of the first element which satisfies the predicate.
+// If no element satisfies the predicate, -1 will be returned.
+func (o *ObservableList[T]) FirstIndexWhere(handler Predicate[T]) int {
+	return o.l.FirstIndexWhere(handler)
+}
+
+// FirstWhere returns the pointer of the first element which satisfies the predicate.
+// If no element satisfies the predicate, nil will be returned.
+func (o *ObservableList[T]) FirstWhere(handler Predicate[T]) *T {
+	return o.l.FirstWhere(handler)
+}
+
+// FirstElementWhere returns the first element which satisfies the predicate.
+// If no element satisfies the predicate, panics.
+func (o *ObservableList[T]) FirstElementWhere(handler Predicate[T]) T {
+	return o.l.FirstElementWhere(handler)
+}
+
+// TryFirstElementWhere returns the first element which satisfies the predicate.
+// If no element satisfies the predicate, ErrNotFound is returned.
+func (o *ObservableList[T]) TryFirstElementWhere(handler Predicate[T]) (T, error) {
+	return o.l.TryFirstElementWhere(handler)
+}
+
+// LastIndexWhere returns the index of the last element which satisfies the predicate.
+// If no element satisfies the predicate, -1 will be returned.
+func (o *ObservableList[T]) LastIndexWhere(handler Predicate[T]) int {
+	return o.l.LastIndexWhere(handler)
+}
+
+// LastWhere returns the pointer of the last element which satisfies the predicate.
+// If no element satisfies the predicate, nil will be returned.
+func (o *ObservableList[T]) LastWhere(handler Predicate[T]) *T {
+	return o.l.LastWhere(handler)
+}
+
+// LastElementWhere returns the last element which satisfies the predicate.
+// If no element satisfies the predicate, panics.
+func (o *ObservableList[T]) LastElementWhere(handler Predicate[T]) T {
+	return o.l.LastElementWhere(handler)
+}
+
+// TryLastElementWhere returns the last element which satisfies the predicate.
+// If no element satisfies the predicate, ErrNotFound is returned.
+func (o *ObservableList[T]) TryLastElementWhere(handler Predicate[T]) (T, error) {
+	return o.l.TryLastElementWhere(handler)
+}
+
+// IndexWhere returns a List[int] for all element index which satisfies the predicate.
+// If no element satisfies the predicate, an empty List will be returned.
+func (o *ObservableList[T]) IndexWhere(handler Predicate[T]) IList[int] {
+	return o.l.IndexWhere(handler)
+}
+
+// Where returns a List with all the elements which satisfies the predicate.
+// If no element satisfies the predicate, an empty List will be returned.
+func (o *ObservableList[T]) Where(handler Predicate[T]) IList[T] {
+	return o.l.Where(handler)
+}
+
+// Map iterates over the element of the ObservableList calling Mapper, and return a new List with the results.
+func (o *ObservableList[T]) Map(handler Mapper[T]) IList[any] {
+	return o.l.Map(handler)
+}
+
+// Reduce executes the Reducer for each element from the list with the given accumulator, and each result will be the accumulator for the next.
+// The final result will be returned.
+func (o *ObservableList[T]) Reduce(reducer Reducer[T], accumulator any) any {
+	return o.l.Reduce(reducer, accumulator)
+}
+
+// Every returns true if every element in the IList satisfies the predicate.
+func (o *ObservableList[T]) Every(handler Predicate[T]) bool {
+	return o.l.Every(handler)
+}
+
+// Some returns true if at least one element in the IList satisfies the predicate.
+func (o *ObservableList[T]) Some(handler Predicate[T]) bool {
+	return o.l.Some(handler)
+}
+
+// None returns true no element in the IList satisfy the predicate.
+func (o *ObservableList[T]) None(handler Predicate[T]) bool {
+	return o.l.None(handler)
+}
+
+// ForEach calls the Consumer with each element of the IList and its index, in order.
+func (o *ObservableList[T]) ForEach(handler Consumer[T]) {
+	o.l.ForEach(handler)
+}
+
+// ForEachUntil calls the IndexedPredicate with each element of the IList and its index, in order, until it returns true.
+func (o *ObservableList[T]) ForEachUntil(handler IndexedPredicate[T]) {
+	o.l.ForEachUntil(handler)
+}
+
+// ForEachContext calls the Consumer with each element of the IList and its index, in order, checking the context before each call.
+// Once the context is done, it stops and returns the context error.
+func (o *ObservableList[T]) ForEachContext(ctx context.Context, handler Consumer[T]) error {
+	return o.l.ForEachContext(ctx, handler)
+}
+
+// Pop removes the last element from the IList and returns itself.
+// If ObservableList is empty (see IsEmpty), panics.
+func (o *ObservableList[T]) Pop() IList[T] {
+	return must(o.TryPop())
+}
+
+// TryPop removes the last element from the IList and returns itself, emitting EventPopped.
+// If ObservableList is empty (see IsEmpty), it is kept unaltered and ErrEmpty is returned.
+func (o *ObservableList[T]) TryPop() (IList[T], error) {
+	return o.tryChange(func() error {
+		last, err := o.l.TryLastElement()
+		if err != nil {
+			return err
+		}
+		index := o.l.Length() - 1
+		if _, err = o.l.TryPop(); err != nil {
+			return err
+		}
+		o.emit(Event[T]{Kind: EventPopped, Index: index, Removed: []T{last}})
+		return nil
+	})
+}
+
+// Shift removes the first element from the IList and then returns itself.
+// If ObservableList is empty (see IsEmpty), panics.
+func (o *ObservableList[T]) Shift() IList[T] {
+	return must(o.TryShift())
+}
+
+// TryShift removes the first element from the IList and then returns itself, emitting EventShifted.
+// If ObservableList is empty (see IsEmpty), it is kept unaltered and ErrEmpty is returned.
+func (o *ObservableList[T]) TryShift() (IList[T], error) {
+	return o.tryChange(func() error {
+		first, err := o.l.TryFirstElement()
+		if err != nil {
+			return err
+		}
+		if _, err = o.l.TryShift(); err != nil {
+			return err
+		}
+		o.emit(Event[T]{Kind: EventShifted, Index: 0, Removed: []T{first}})
+		return nil
+	})
+}
+
+// Set sets the given element at the given index, and then returns itself.
+// If there is no element at the given index, panics.
+func (o *ObservableList[T]) Set(index int, element T) IList[T] {
+	return must(o.TrySet(index, element))
+}
+
+// TrySet sets the given element at the given index, and then returns itself, emitting EventSet.
+// If the wrapped IList rejects it (e.g. there is no element at the given index), it is kept unaltered and its error is returned.
+func (o *ObservableList[T]) TrySet(index int, element T) (IList[T], error) {
+	return o.tryChange(func() error {
+		previous, err := o.l.TryElementAt(index)
+		if err != nil {
+			return err
+		}
+		if _, err = o.l.TrySet(index, element); err != nil {
+			return err
+		}
+		o.emit(Event[T]{Kind: EventSet, Index: index, Elements: []T{element}, Removed: []T{previous}})
+		return nil
+	})
+}
+
+// InsertAt inserts the given elements at the given index, moving the following elements forward, and then returns itself.
+// It emits EventInserted. The index may be equal to Length, to insert at the end.
+// Otherwise, if there is no element at the given index, panics.
+func (o *ObservableList[T]) InsertAt(index int, elements ...T) IList[T] {
+	return o.change(func() {
+		o.l.InsertAt(index, elements...)
+		o.emit(Event[T]{Kind: EventInserted, Index: index, Elements: copyOf(elements)})
+	})
+}
+
+// RemoveAt removes the element at the given index, moving the following elements backward, and then returns itself.
+// It emits EventRemoved. If there is no element at the given index, panics.
+func (o *ObservableList[T]) RemoveAt(index int) IList[T] {
+	return o.RemoveRange(index, index)
+}
+
+// RemoveRange removes all elements between the *from* and *to* indexes, and then returns itself.
+// It emits EventRemoved. If the interval is not within the ObservableList bounds, panics.
+func (o *ObservableList[T]) RemoveRange(from, to int) IList[T] {
+	return o.change(func() {
+		removed := must(o.l.TryInterval(from, to))
+		o.l.RemoveRange(from, to)
+		o.emit(Event[T]{Kind: EventRemoved, Index: from, Removed: removed.Elements()})
+	})
+}
+
+// Splice removes deleteCount elements starting at the *start* index, inserts the given elements in their place,
+// and returns a new List with the removed elements. deleteCount is limited to the elements available after start.
+// It emits EventRemoved and then EventInserted, each only if there are elements removed or inserted.
+// The start index may be equal to Length, to insert at the end. Otherwise, if there is no element at the given index, panics.
+func (o *ObservableList[T]) Splice(start, deleteCount int, elements ...T) (removed IList[T]) {
+	o.change(func() {
+		removed = o.l.Splice(start, deleteCount, elements...)
+		if removed.IsNotEmpty() {
+			o.emit(Event[T]{Kind: EventRemoved, Index: start, Removed: copyOf(removed.Elements())})
+		}
+		if len(elements) > 0 {
+			o.emit(Event[T]{Kind: EventInserted, Index: start, Elements: copyOf(elements)})
+		}
+	})
+	return
+}
+
+// RemoveWhere removes all the elements which satisfies the predicate, and then returns itself.
+// It emits EventRemoved with Index -1, if any element was removed.
+func (o *ObservableList[T]) RemoveWhere(handler Predicate[T]) IList[T] {
+	return o.change(func() {
+		var removed []T
+		o.l.RemoveWhere(func(v T) bool {
+			if handler(v) {
+				removed = append(removed, v)
+				return true
+			}
+			return false
+		})
+		if len(removed) > 0 {
+			o.emit(Event[T]{Kind: EventRemoved, Index: -1, Removed: removed})
+		}
+	})
+}
+
+// Interval returns a new List with all elements between the *from* and *to* indexes.
+// If the interval is not within the ObservableList bounds, panics.
+func (o *ObservableList[T]) Interval(from, to int) IList[T] {
+	return o.l.Interval(from, to)
+}
+
+// TryInterval returns a new List with all elements between the *from* and *to* indexes.
+// If the interval is not within the ObservableList bounds, ErrIndexOutOfRange is returned.
+func (o *ObservableList[T]) TryInterval(from, to int) (IList[T], error) {
+	return o.l.TryInterval(from, to)
+}
+
+// Slice returns a new List with the elements from the *from* index (inclusive) to the *to* index (exclusive), taking every *step* element.
+// Negative indexes are counted from the end of the ObservableList (-1 is the last element), and out-of-range bounds are clamped.
+// A negative step walks backwards, from *from* down to *to*. A zero step panics with ErrZeroStep.
+func (o *ObservableList[T]) Slice(from, to, step int) IList[T] {
+	return o.l.Slice(from, to, step)
+}
+
+// String returns a string representation of the ObservableList.
+func (o *ObservableList[T]) String() string {
+	return o.l.String()
+}
+
+// Join returns the string representation of each element in the IList, separated by the given separator
+func (o *ObservableList[T]) Join(separator string) string {
+	return o.l.Join(separator)
+}
+
+// Sort receives a Sorter function to sort its elements, and returns itself after sorted, emitting EventSorted.
+// Sort is stable: elements considered equal by the Sorter keep their original order.
+func (o *ObservableList[T]) Sort(sorter Sorter[T]) IList[T] {
+	return o.change(func() {
+		o.l.Sort(sorter)
+		o.emit(Event[T]{Kind: EventSorted, Index: 0})
+	})
+}
+
+// Clear removes all elements from the ObservableList, making it empty, and then returns itself.
+// It emits EventCleared.
+func (o *ObservableList[T]) Clear() IList[T] {
+	return o.change(func() {
+		removed := copyOf(o.l.Elements())
+		o.l.Clear()
+		o.emit(Event[T]{Kind: EventCleared, Index: 0, Removed: removed})
+	})
+}
+
+// IsDynamicallySized returns true if the wrapped IList is dynamically-sized
+func (o *ObservableList[T]) IsDynamicallySized() bool {
+	return o.l.IsDynamicallySized()
+}
+
+// IsThreadSafe returns true if the wrapped IList is thread-safe
+func (o *ObservableList[T]) IsThreadSafe() bool {
+	return o.l.IsThreadSafe()
+}
+
+func (o *ObservableList[T]) UnmarshalJSON(data []byte) error {
+	var elements []T
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return err
+	}
+	o.Clear().Push(elements...)
+	return nil
+}
+
+func (o *ObservableList[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.l)
+}
+
+// change runs the given change while the ObservableList is locked, and then returns itself.
+func (o *ObservableList[T]) change(exec func()) IList[T] {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	exec()
+	return o
+}
+
+// tryChange runs the given change while the ObservableList is locked, and then returns itself with the change error.
+func (o *ObservableList[T]) tryChange(exec func() error) (IList[T], error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o, exec()
+}
+
+// emit sends the given Event to each listener. It must be called while the ObservableList is locked.
+func (o *ObservableList[T]) emit(event Event[T]) {
+	for _, l := range o.listeners {
+		if l.queue == nil {
+			l.handle(event)
+		} else {
+			l.queue.enqueue([]Event[T]{event})
+		}
+	}
+}
+
+// register adds the given listener, and returns the function which removes it.
+func (o *ObservableList[T]) register(l *listener[T]) func() {
+	o.change(func() {
+		o.listeners = append(o.listeners, l)
+	})
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			o.change(func() {
+				for i, v := range o.listeners {
+					if v == l {
+						o.listeners = append(o.listeners[:i], o.listeners[i+1:]...)
+						break
+					}
+				}
+			})
+			if l.done != nil {
+				close(l.done)
+			}
+		})
+	}
+}
+
+// deliver calls the asynchronous listener for each queued Event, in order, until it is unregistered and its queue is empty.
+func (l *listener[T]) deliver() {
+	for {
+		for _, event := range l.queue.dequeue() {
+			l.handle(event)
+		}
+		select {
+		case <-l.queue.signal:
+		case <-l.done:
+			for _, event := range l.queue.dequeue() {
+				l.handle(event)
+			}
+			return
+		}
+	}
+}
+
+// copyOf returns a copy of the given slice, so it is not changed by the caller after being emitted.
+func copyOf[T any](elements []T) []T {
+	return append([]T(nil), elements...)
+}
@@ -0,0 +1,140 @@
+package lists
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func describe(event Event[int]) string {
+	kinds := []string{"Pushed", "Set", "Popped", "Shifted", "Sorted", "Cleared", "Inserted", "Removed"}
+	return fmt.Sprintf("%v@%v+%v-%v", kinds[event.Kind], event.Index, fmtInts(event.Elements), fmtInts(event.Removed))
+}
+
+func TestObservableList_Events(t *testing.T) {
+	list := NewObservableList[int](NewList(3, 1, 2))
+	var events []string
+	list.OnChange(func(event Event[int]) {
+		events = append(events, describe(event))
+	})
+	list.Push(4, 5).Pop().Shift().Set(0, 6).Sort(ascending)
+	list.InsertAt(1, 7).RemoveAt(0).RemoveRange(0, 1)
+	list.Push(1, 2, 3)
+	list.Splice(1, 1, 8, 9)
+	list.RemoveWhere(func(v int) bool {
+		return v > 7
+	}).Clear()
+	if _, err := list.TryPop(); !errors.Is(err, ErrEmpty) {
+		t.Errorf("TryPop should return ErrEmpty. Got: %v", err)
+	}
+	if _, err := list.TrySet(0, 1); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Errorf("TrySet should return ErrIndexOutOfRange. Got: %v", err)
+	}
+	expected := []string{
+		"Pushed@3+4,5-", "Popped@4+-5", "Shifted@0+-3", "Set@0+6-1", "Sorted@0+-",
+		"Inserted@1+7-", "Removed@0+-2", "Removed@0+-7,4", "Pushed@1+1,2,3-",
+		"Removed@1+-1", "Inserted@1+8,9-", "Removed@-1+-8,9", "Cleared@0+-6,2,3",
+	}
+	if strings.Join(events, " ") != strings.Join(expected, " ") {
+		t.Errorf("ObservableList should emit an Event for each change.\nExpected: %v\nGot:      %v", expected, events)
+	}
+}
+
+func TestObservableList_Unsubscribe(t *testing.T) {
+	list := NewObservableList[int](NewList[int]())
+	calls := 0
+	unsubscribe := list.OnChange(func(Event[int]) {
+		calls++
+	})
+	list.Push(1)
+	unsubscribe()
+	unsubscribe()
+	list.Push(2)
+	if calls != 1 || list.Join(",") != "1,2" {
+		t.Errorf("ObservableList should not call unsubscribed listeners. Got %v calls", calls)
+	}
+	if cloned := list.Clone().(*ObservableList[int]); len(cloned.listeners) != 0 || cloned.Join(",") != "1,2" {
+		t.Errorf("ObservableList clones should not have listeners. Got: %v", cloned)
+	}
+}
+
+func TestObservableList_Async(t *testing.T) {
+	goroutines := runtime.NumGoroutine()
+	list := NewObservableList[int](NewSafeList[int]())
+	var (
+		mu     sync.Mutex
+		pushed []int
+	)
+	done := make(chan struct{})
+	unsubscribe := list.OnChangeAsync(func(event Event[int]) {
+		mu.Lock()
+		defer mu.Unlock()
+		pushed = append(pushed, event.Elements...)
+		if event.Elements[0] == 0 {
+			list.Push(-1)
+		}
+		if event.Elements[0] == -1 {
+			close(done)
+		}
+	})
+	for i := 0; i < 100; i++ {
+		list.Push(i)
+	}
+	<-done
+	unsubscribe()
+	if !settled(goroutines) {
+		t.Error("OnChangeAsync should not leak its goroutine after unsubscribed")
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if len(pushed) != 101 || pushed[0] != 0 || pushed[99] != 99 || pushed[100] != -1 {
+		t.Errorf("OnChangeAsync should deliver every Event in order. Got: %v", pushed)
+	}
+}
+
+func TestObservableList_Concurrency(t *testing.T) {
+	list := NewObservableList[int](NewSafeList[int]())
+	var lengths []int
+	list.OnChange(func(event Event[int]) {
+		lengths = append(lengths, event.Index)
+	})
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				list.Push(j)
+			}
+		}()
+	}
+	wg.Wait()
+	for i, v := range lengths {
+		if v != i {
+			t.Fatalf("ObservableList should emit Events in the order of the changes. Got index %v at %v", v, i)
+		}
+	}
+	if len(lengths) != 800 || !list.IsThreadSafe() {
+		t.Errorf("ObservableList should emit an Event for each change. Got: %v", len(lengths))
+	}
+}
+
+func TestObservableList_NotObservable(t *testing.T) {
+	for _, list := range []IList[int]{
+		NewSortedList[int](ascending, 2, 1),
+		NewFixedList[int](2).OnOverflow(OverflowEvict),
+		NewSafeFixedList[int](2),
+	} {
+		func() {
+			defer func() {
+				if r := recover(); r != ErrNotObservable {
+					t.Errorf("NewObservableList should panic with ErrNotObservable for %T. Got: %v", list, r)
+				}
+			}()
+			NewObservableList(list)
+		}()
+	}
+}
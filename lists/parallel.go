@@ -0,0 +1,186 @@
+package lists
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// ParallelOption configures how the Parallel functions split their work.
+type ParallelOption func(*parallelism)
+
+// WithWorkers sets how many goroutines process the elements. It defaults to GOMAXPROCS.
+// If workers is not greater than zero, panics with ErrInvalidSize.
+func WithWorkers(workers int) ParallelOption {
+	if workers <= 0 {
+		panic(ErrInvalidSize)
+	}
+	return func(p *parallelism) {
+		p.workers = workers
+	}
+}
+
+// WithChunkSize sets how many consecutive elements a worker takes at a time.
+// It defaults to a size which gives each worker about four chunks.
+// If size is not greater than zero, panics with ErrInvalidSize.
+func WithChunkSize(size int) ParallelOption {
+	if size <= 0 {
+		panic(ErrInvalidSize)
+	}
+	return func(p *parallelism) {
+		p.chunkSize = size
+	}
+}
+
+// ParallelWhere returns a new List with only the elements which satisfies the predicate, in their original order.
+// The predicate is called concurrently, so it must be safe for concurrent use.
+func ParallelWhere[T any](list IList[T], handler Predicate[T], options ...ParallelOption) IList[T] {
+	elements := elementsOf(list)
+	p := newParallelism(len(elements), options)
+	satisfied := make([][]T, p.chunks())
+	p.run(func(chunk, from, to int) bool {
+		for _, v := range elements[from:to] {
+			if handler(v) {
+				satisfied[chunk] = append(satisfied[chunk], v)
+			}
+		}
+		return true
+	})
+	where := NewList[T]()
+	for _, v := range satisfied {
+		where.Push(v...)
+	}
+	return where
+}
+
+// ParallelMap iterates over the elements of the given IList calling TypeMapper concurrently,
+// and return a new IList with the typed results, in their original order.
+// The mapper must be safe for concurrent use.
+func ParallelMap[F, T any](list IList[F], mapper TypeMapper[F, T], options ...ParallelOption) IList[T] {
+	elements := elementsOf(list)
+	mapped := make(List[T], len(elements))
+	newParallelism(len(elements), options).run(func(_, from, to int) bool {
+		for i := from; i < to; i++ {
+			mapped[i] = mapper(elements[i])
+		}
+		return true
+	})
+	return &mapped
+}
+
+// ParallelEach calls the handler concurrently for each element of the given IList, and returns after all calls are done.
+// There is no guarantee on the order of the calls, so the handler must be safe for concurrent use.
+func ParallelEach[T any](list IList[T], handler func(T), options ...ParallelOption) {
+	elements := elementsOf(list)
+	newParallelism(len(elements), options).run(func(_, from, to int) bool {
+		for _, v := range elements[from:to] {
+			handler(v)
+		}
+		return true
+	})
+}
+
+// ParallelSome returns true if any element satisfies the predicate, which is called concurrently.
+// Once an element satisfies it, all workers stop, so the predicate may not be called for the remaining elements.
+func ParallelSome[T any](list IList[T], handler Predicate[T], options ...ParallelOption) bool {
+	elements := elementsOf(list)
+	var some atomic.Bool
+	newParallelism(len(elements), options).run(func(_, from, to int) bool {
+		for _, v := range elements[from:to] {
+			if some.Load() {
+				return false
+			}
+			if handler(v) {
+				some.Store(true)
+				return false
+			}
+		}
+		return true
+	})
+	return some.Load()
+}
+
+// ParallelEvery returns true if every element satisfies the predicate, which is called concurrently.
+// Once an element does not satisfy it, all workers stop, so the predicate may not be called for the remaining elements.
+func ParallelEvery[T any](list IList[T], handler Predicate[T], options ...ParallelOption) bool {
+	return !ParallelSome(list, func(v T) bool {
+		return !handler(v)
+	}, options...)
+}
+
+// parallelism splits a given amount of elements in chunks, to be processed by a pool of workers.
+type parallelism struct {
+	length    int
+	workers   int
+	chunkSize int
+}
+
+// newParallelism returns a parallelism for the given amount of elements, with the given options applied.
+func newParallelism(length int, options []ParallelOption) *parallelism {
+	p := &parallelism{length: length, workers: runtime.GOMAXPROCS(0)}
+	for _, option := range options {
+		option(p)
+	}
+	if p.chunkSize == 0 {
+		p.chunkSize = length / (p.workers * 4)
+		if p.chunkSize == 0 {
+			p.chunkSize = 1
+		}
+	}
+	return p
+}
+
+// chunks returns how many chunks the elements are split in.
+func (p *parallelism) chunks() int {
+	return (p.length + p.chunkSize - 1) / p.chunkSize
+}
+
+// run calls work for each chunk, with its index and its [from, to) bounds, and returns after all calls are done.
+// Chunks are taken by the workers in order. Once any work returns false, no other chunk is taken.
+// If any work panics, the remaining workers stop, and run panics with the same value in the calling goroutine.
+func (p *parallelism) run(work func(chunk, from, to int) bool) {
+	chunks := p.chunks()
+	workers := p.workers
+	if workers > chunks {
+		workers = chunks
+	}
+	var (
+		next      atomic.Int64
+		stopped   atomic.Bool
+		wg        sync.WaitGroup
+		recovered any
+		once      sync.Once
+	)
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			defer func() {
+				if r := recover(); r != nil {
+					once.Do(func() {
+						recovered = r
+					})
+					stopped.Store(true)
+				}
+			}()
+			for !stopped.Load() {
+				chunk := int(next.Add(1) - 1)
+				if chunk >= chunks {
+					return
+				}
+				from := chunk * p.chunkSize
+				to := from + p.chunkSize
+				if to > p.length {
+					to = p.length
+				}
+				if !work(chunk, from, to) {
+					stopped.Store(true)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if recovered != nil {
+		panic(recovered)
+	}
+}
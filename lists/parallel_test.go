@@ -0,0 +1,119 @@
+package lists
+
+import (
+	"fmt"
+	"sync/atomic"
+	"testing"
+)
+
+func sequence(length int) *List[int] {
+	list := NewList[int]()
+	for i := 0; i < length; i++ {
+		list.Push(i)
+	}
+	return list
+}
+
+func TestParallelWhere(t *testing.T) {
+	list := sequence(1000)
+	even := func(v int) bool {
+		return v%2 == 0
+	}
+	expected := list.Where(even).String()
+	for _, options := range [][]ParallelOption{
+		nil,
+		{WithWorkers(1)},
+		{WithWorkers(4), WithChunkSize(7)},
+		{WithWorkers(16), WithChunkSize(1)},
+	} {
+		if where := ParallelWhere[int](list, even, options...); where.String() != expected {
+			t.Errorf("ParallelWhere should keep the order. Got: %v", where)
+		}
+	}
+	if ParallelWhere[int](NewList[int](), even).IsNotEmpty() {
+		t.Error("ParallelWhere of an empty list should be empty")
+	}
+}
+
+func TestParallelMap(t *testing.T) {
+	mapped := ParallelMap[int, string](sequence(100), func(v int) string {
+		return fmt.Sprint(v * 2)
+	}, WithWorkers(3), WithChunkSize(9))
+	if mapped.Length() != 100 || mapped.ElementAt(0) != "0" || mapped.ElementAt(99) != "198" || mapped.ElementAt(50) != "100" {
+		t.Errorf("ParallelMap should keep the order. Got: %v", mapped)
+	}
+}
+
+func TestParallelEach(t *testing.T) {
+	var sum atomic.Int64
+	ParallelEach[int](sequence(101), func(v int) {
+		sum.Add(int64(v))
+	}, WithWorkers(4))
+	if sum.Load() != 5050 {
+		t.Errorf("ParallelEach should call the handler for each element. Got: %v", sum.Load())
+	}
+}
+
+func TestParallelSome(t *testing.T) {
+	list := sequence(10000)
+	var calls atomic.Int64
+	some := ParallelSome[int](list, func(v int) bool {
+		calls.Add(1)
+		return v == 0
+	}, WithWorkers(4), WithChunkSize(10))
+	if !some {
+		t.Error("ParallelSome should be true")
+	}
+	if calls.Load() >= int64(list.Length()/2) {
+		t.Errorf("ParallelSome should stop after an element satisfies the predicate. Got %v calls", calls.Load())
+	}
+	if ParallelSome[int](list, func(v int) bool { return v < 0 }) {
+		t.Error("ParallelSome should be false")
+	}
+	if !ParallelEvery[int](list, func(v int) bool { return v >= 0 }) || ParallelEvery[int](list, func(v int) bool { return v < 9999 }) {
+		t.Error("ParallelEvery should be true only if every element satisfies the predicate")
+	}
+	if !ParallelEvery[int](NewList[int](), func(v int) bool { return false }) {
+		t.Error("ParallelEvery of an empty list should be true")
+	}
+}
+
+func TestParallel_SafeList(t *testing.T) {
+	list := NewSafeList(sequence(100).Elements()...)
+	where := ParallelWhere[int](list, func(v int) bool {
+		list.Push(v)
+		return v < 10
+	}, WithWorkers(4), WithChunkSize(3))
+	if where.Length() != 10 || list.Length() != 200 {
+		t.Errorf("Parallel functions should iterate over a snapshot of SafeList. Got: %v and %v", where.Length(), list.Length())
+	}
+}
+
+func TestParallel_Panics(t *testing.T) {
+	defer func() {
+		if r := recover(); r != "boom" {
+			t.Errorf("Parallel functions should panic in the calling goroutine. Got: %v", r)
+		}
+	}()
+	ParallelEach[int](sequence(100), func(v int) {
+		if v == 42 {
+			panic("boom")
+		}
+	}, WithWorkers(4))
+}
+
+func TestParallel_InvalidOptions(t *testing.T) {
+	for name, option := range map[string]func(){
+		"WithWorkers":   func() { WithWorkers(0) },
+		"WithChunkSize": func() { WithChunkSize(-1) },
+	} {
+		func() {
+			defer func() {
+				if r := recover(); r != ErrInvalidSize {
+					t.Errorf("%v should panic with ErrInvalidSize. Got: %v", name, r)
+				}
+			}()
+			option()
+		}()
+	}
+}
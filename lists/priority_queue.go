@@ -0,0 +1,364 @@
+package lists
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+// Item is a handle to an element of a PriorityQueue, used to Update or Remove it in O(log n).
+// It stays valid while the element is in the PriorityQueue, even when moved to another one by Merge,
+// although a SafePriorityQueue Merge leaves it briefly in neither of them.
+// Its PriorityQueue is stored atomically, so any PriorityQueue may check whether it owns an Item, even while another one holds it.
+type Item[T any] struct {
+	value T
+	index int
+	queue atomic.Pointer[PriorityQueue[T]]
+}
+
+// newItem returns a new Item with the given element, at the given index of the given PriorityQueue.
+func newItem[T any](q *PriorityQueue[T], element T, index int) *Item[T] {
+	item := &Item[T]{value: element, index: index}
+	item.queue.Store(q)
+	return item
+}
+
+// Value returns the element held by the Item.
+// Items of a SafePriorityQueue may be updated concurrently, so their elements must be read through SafePriorityQueue.Value instead.
+func (i *Item[T]) Value() T {
+	return i.value
+}
+
+// NewPriorityQueue returns a new PriorityQueue ordered by the given Sorter, with the given elements.
+// It is built in O(n).
+func NewPriorityQueue[T any](sorter Sorter[T], elements ...T) *PriorityQueue[T] {
+	q := &PriorityQueue[T]{sorter: sorter}
+	for _, v := range elements {
+		q.items = append(q.items, newItem(q, v, len(q.items)))
+	}
+	q.heapify()
+	return q
+}
+
+// NewPriorityQueueFrom returns a new PriorityQueue ordered by the given Sorter, with the elements of the given IList.
+// It is built in O(n). Thread-safe implementations are read through a consistent copy.
+func NewPriorityQueueFrom[T any](sorter Sorter[T], list IList[T]) *PriorityQueue[T] {
+	return NewPriorityQueue(sorter, elementsOf(list)...)
+}
+
+// PriorityQueue is a binary heap, and thread-unsafe, collection whose head is always its first element in the Sorter order:
+// the smallest one, for an ascending Sorter. Push, Pop, Update and Remove run in O(log n), and Peek in O(1).
+// Elements considered equal by the Sorter are popped in no particular order.
+type PriorityQueue[T any] struct {
+	items  []*Item[T]
+	sorter Sorter[T]
+}
+
+// Length returns how many elements are in the PriorityQueue.
+func (q *PriorityQueue[T]) Length() int {
+	return len(q.items)
+}
+
+// IsEmpty returns true if there are *no* elements stored in the PriorityQueue.
+func (q *PriorityQueue[T]) IsEmpty() bool {
+	return len(q.items) == 0
+}
+
+// IsNotEmpty returns true if there are elements stored in the PriorityQueue.
+func (q *PriorityQueue[T]) IsNotEmpty() bool {
+	return len(q.items) > 0
+}
+
+// Push adds the given element to the PriorityQueue, and returns its Item.
+func (q *PriorityQueue[T]) Push(element T) *Item[T] {
+	item := newItem(q, element, len(q.items))
+	q.items = append(q.items, item)
+	q.up(item.index)
+	return item
+}
+
+// Pop removes the head of the PriorityQueue, and returns it and true.
+// If the PriorityQueue is empty, the zero value and false are returned.
+func (q *PriorityQueue[T]) Pop() (T, bool) {
+	if q.IsEmpty() {
+		var zero T
+		return zero, false
+	}
+	return q.Remove(q.items[0])
+}
+
+// Peek returns the head of the PriorityQueue and true, without removing it.
+// If the PriorityQueue is empty, the zero value and false are returned.
+func (q *PriorityQueue[T]) Peek() (T, bool) {
+	if q.IsEmpty() {
+		var zero T
+		return zero, false
+	}
+	return q.items[0].value, true
+}
+
+// Update replaces the element of the given Item, moving it to its new position, and returns true.
+// If the Item is not in the PriorityQueue, it returns false.
+func (q *PriorityQueue[T]) Update(item *Item[T], element T) bool {
+	if item == nil || item.queue.Load() != q {
+		return false
+	}
+	item.value = element
+	q.fix(item.index)
+	return true
+}
+
+// Remove removes the element of the given Item from the PriorityQueue, and returns it and true.
+// If the Item is not in the PriorityQueue, the zero value and false are returned.
+func (q *PriorityQueue[T]) Remove(item *Item[T]) (T, bool) {
+	if item == nil || item.queue.Load() != q {
+		var zero T
+		return zero, false
+	}
+	i, last := item.index, len(q.items)-1
+	q.swap(i, last)
+	q.items[last] = nil
+	q.items = q.items[:last]
+	if i < last {
+		q.fix(i)
+	}
+	item.queue.Store(nil)
+	return item.value, true
+}
+
+// Merge moves all elements of the other PriorityQueue to this one, leaving the other empty, in O(n + m).
+// Their Items stay valid, and now belong to this PriorityQueue, which keeps its own Sorter.
+func (q *PriorityQueue[T]) Merge(other *PriorityQueue[T]) {
+	if other == nil || other == q {
+		return
+	}
+	q.adopt(other.drain())
+}
+
+// Clear removes all elements from the PriorityQueue, making it empty. Its Items are no longer valid.
+func (q *PriorityQueue[T]) Clear() {
+	for _, item := range q.items {
+		item.queue.Store(nil)
+	}
+	q.items = nil
+}
+
+// ToList returns a new List with the elements of the PriorityQueue in the Sorter order, so its first element is the head.
+// The PriorityQueue is kept unaltered. It runs in O(n log n).
+func (q *PriorityQueue[T]) ToList() IList[T] {
+	list := NewList[T]()
+	for _, item := range q.items {
+		list.Push(item.value)
+	}
+	return list.Sort(q.sorter)
+}
+
+// String returns a string representation of the PriorityQueue, in the Sorter order.
+func (q *PriorityQueue[T]) String() string {
+	return q.ToList().String()
+}
+
+// IsThreadSafe returns false, as PriorityQueue is not thread-safe
+func (q *PriorityQueue[T]) IsThreadSafe() bool {
+	return false
+}
+
+// drain removes and returns all Items, detaching them until adopted by another PriorityQueue (see adopt).
+func (q *PriorityQueue[T]) drain() []*Item[T] {
+	items := q.items
+	for _, item := range items {
+		item.queue.Store(nil)
+	}
+	q.items = nil
+	return items
+}
+
+// adopt adds the given Items, making them belong to the PriorityQueue, and then restores the heap order in O(n).
+func (q *PriorityQueue[T]) adopt(items []*Item[T]) {
+	for _, item := range items {
+		item.index = len(q.items)
+		item.queue.Store(q)
+		q.items = append(q.items, item)
+	}
+	q.heapify()
+}
+
+// heapify restores the heap order of all elements, in O(n).
+func (q *PriorityQueue[T]) heapify() {
+	for i := len(q.items)/2 - 1; i >= 0; i-- {
+		q.down(i)
+	}
+}
+
+// fix restores the heap order after the element at the given index has changed.
+func (q *PriorityQueue[T]) fix(i int) {
+	if !q.down(i) {
+		q.up(i)
+	}
+}
+
+// up moves the element at the given index towards the head, while it comes before its parent.
+func (q *PriorityQueue[T]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !q.less(i, parent) {
+			return
+		}
+		q.swap(i, parent)
+		i = parent
+	}
+}
+
+// down moves the element at the given index away from the head, while any child comes before it, and returns true if moved.
+func (q *PriorityQueue[T]) down(i int) bool {
+	start := i
+	for {
+		first := 2*i + 1
+		if first >= len(q.items) {
+			break
+		}
+		if second := first + 1; second < len(q.items) && q.less(second, first) {
+			first = second
+		}
+		if !q.less(first, i) {
+			break
+		}
+		q.swap(i, first)
+		i = first
+	}
+	return i > start
+}
+
+func (q *PriorityQueue[T]) less(i, j int) bool {
+	return q.sorter(q.items[i].value, q.items[j].value) < 0
+}
+
+func (q *PriorityQueue[T]) swap(i, j int) {
+	q.items[i], q.items[j] = q.items[j], q.items[i]
+	q.items[i].index, q.items[j].index = i, j
+}
+
+// NewSafePriorityQueue returns a new SafePriorityQueue ordered by the given Sorter, with the given elements.
+func NewSafePriorityQueue[T any](sorter Sorter[T], elements ...T) *SafePriorityQueue[T] {
+	return &SafePriorityQueue[T]{q: NewPriorityQueue(sorter, elements...)}
+}
+
+// NewSafePriorityQueueFrom returns a new SafePriorityQueue ordered by the given Sorter, with the elements of the given IList.
+func NewSafePriorityQueueFrom[T any](sorter Sorter[T], list IList[T]) *SafePriorityQueue[T] {
+	return &SafePriorityQueue[T]{q: NewPriorityQueueFrom(sorter, list)}
+}
+
+// SafePriorityQueue is the thread-safe variant of PriorityQueue.
+// Read-only methods share a read lock, so they may run concurrently. Methods which change the SafePriorityQueue take an exclusive lock.
+// The Sorter runs while the lock is held, so it must not use the same SafePriorityQueue.
+type SafePriorityQueue[T any] struct {
+	q *PriorityQueue[T]
+	sync.RWMutex
+}
+
+// Length returns how many elements are in the SafePriorityQueue.
+func (s *SafePriorityQueue[T]) Length() int {
+	s.RLock()
+	defer s.RUnlock()
+	return s.q.Length()
+}
+
+// IsEmpty returns true if there are *no* elements stored in the SafePriorityQueue.
+func (s *SafePriorityQueue[T]) IsEmpty() bool {
+	return s.Length() == 0
+}
+
+// IsNotEmpty returns true if there are elements stored in the SafePriorityQueue.
+func (s *SafePriorityQueue[T]) IsNotEmpty() bool {
+	return s.Length() > 0
+}
+
+// Push adds the given element to the SafePriorityQueue, and returns its Item.
+func (s *SafePriorityQueue[T]) Push(element T) *Item[T] {
+	s.Lock()
+	defer s.Unlock()
+	return s.q.Push(element)
+}
+
+// Pop removes the head of the SafePriorityQueue, and returns it and true.
+// If the SafePriorityQueue is empty, the zero value and false are returned.
+func (s *SafePriorityQueue[T]) Pop() (T, bool) {
+	s.Lock()
+	defer s.Unlock()
+	return s.q.Pop()
+}
+
+// Peek returns the head of the SafePriorityQueue and true, without removing it.
+// If the SafePriorityQueue is empty, the zero value and false are returned.
+func (s *SafePriorityQueue[T]) Peek() (T, bool) {
+	s.RLock()
+	defer s.RUnlock()
+	return s.q.Peek()
+}
+
+// Value returns the element of the given Item and true, as Item.Value does, but under the SafePriorityQueue lock.
+// If the Item is not in the SafePriorityQueue, the zero value and false are returned.
+func (s *SafePriorityQueue[T]) Value(item *Item[T]) (T, bool) {
+	s.RLock()
+	defer s.RUnlock()
+	if item == nil || item.queue.Load() != s.q {
+		var zero T
+		return zero, false
+	}
+	return item.value, true
+}
+
+// Update replaces the element of the given Item, moving it to its new position, and returns true.
+// If the Item is not in the SafePriorityQueue, it returns false.
+func (s *SafePriorityQueue[T]) Update(item *Item[T], element T) bool {
+	s.Lock()
+	defer s.Unlock()
+	return s.q.Update(item, element)
+}
+
+// Remove removes the element of the given Item from the SafePriorityQueue, and returns it and true.
+// If the Item is not in the SafePriorityQueue, the zero value and false are returned.
+func (s *SafePriorityQueue[T]) Remove(item *Item[T]) (T, bool) {
+	s.Lock()
+	defer s.Unlock()
+	return s.q.Remove(item)
+}
+
+// Merge moves all elements of the other SafePriorityQueue to this one, leaving the other empty.
+// The other SafePriorityQueue is emptied under its own lock first, so two queues may be merged into each other concurrently.
+// Until this one adopts them, the moved Items belong to neither: Update and Remove return false for them on both.
+func (s *SafePriorityQueue[T]) Merge(other *SafePriorityQueue[T]) {
+	if other == nil || other == s {
+		return
+	}
+	other.Lock()
+	items := other.q.drain()
+	other.Unlock()
+	s.Lock()
+	defer s.Unlock()
+	s.q.adopt(items)
+}
+
+// Clear removes all elements from the SafePriorityQueue, making it empty. Its Items are no longer valid.
+func (s *SafePriorityQueue[T]) Clear() {
+	s.Lock()
+	defer s.Unlock()
+	s.q.Clear()
+}
+
+// ToList returns a new SafeList with the elements of the SafePriorityQueue in the Sorter order, so its first element is the head.
+func (s *SafePriorityQueue[T]) ToList() IList[T] {
+	s.RLock()
+	defer s.RUnlock()
+	return NewSafeListFrom(s.q.ToList().Elements())
+}
+
+// String returns a string representation of the SafePriorityQueue, in the Sorter order.
+func (s *SafePriorityQueue[T]) String() string {
+	return fmt.Sprint(s.ToList())
+}
+
+// IsThreadSafe returns true, as SafePriorityQueue is thread-safe
+func (s *SafePriorityQueue[T]) IsThreadSafe() bool {
+	return true
+}
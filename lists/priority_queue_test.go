@@ -0,0 +1,186 @@
+package lists
+
+import (
+	"math/rand"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func drained(q *PriorityQueue[int]) *List[int] {
+	popped := NewList[int]()
+	for v, ok := q.Pop(); ok; v, ok = q.Pop() {
+		popped.Push(v)
+	}
+	return popped
+}
+
+func TestPriorityQueue(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	list := NewList[int]()
+	for i := 0; i < 1000; i++ {
+		list.Push(random.Intn(100))
+	}
+	q := NewPriorityQueueFrom[int](ascending, list)
+	head, _ := q.Peek()
+	expected := list.Clone().Sort(ascending)
+	if head != expected.FirstElement() || q.ToList().String() != expected.String() || q.Length() != 1000 {
+		t.Errorf("PriorityQueue.ToList should be sorted. Got: %v", q.ToList())
+	}
+	q.Push(-1)
+	q.Push(101)
+	expected.InsertAt(0, -1).Push(101)
+	if popped := drained(q); popped.String() != expected.String() {
+		t.Errorf("PriorityQueue should pop in the Sorter order. Got: %v", popped)
+	}
+	if v, ok := q.Pop(); ok || v != 0 || q.IsNotEmpty() {
+		t.Errorf("Pop should be false when empty. Got: %v, %v", v, ok)
+	}
+	if _, ok := q.Peek(); ok {
+		t.Error("Peek should be false when empty")
+	}
+}
+
+func TestPriorityQueue_Items(t *testing.T) {
+	q := NewPriorityQueue[int](ascending, 5, 3, 8)
+	one := q.Push(1)
+	seven := q.Push(7)
+	if !q.Update(seven, 0) || !q.Update(one, 9) {
+		t.Error("Update should be true for Items in the PriorityQueue")
+	}
+	if v, ok := q.Remove(q.Push(4)); v != 4 || !ok {
+		t.Errorf("Remove should return the removed element. Got: %v, %v", v, ok)
+	}
+	if head, _ := q.Peek(); head != 0 || seven.Value() != 0 {
+		t.Errorf("Update should move the element. Got: %v", head)
+	}
+	if popped := drained(q); popped.Join(",") != "0,3,5,8,9" {
+		t.Errorf("PriorityQueue should pop updated elements in order. Got: %v", popped)
+	}
+	if q.Update(seven, 1) || q.Update(nil, 1) {
+		t.Error("Update should be false for removed Items")
+	}
+	if _, ok := q.Remove(one); ok {
+		t.Error("Remove should be false for removed Items")
+	}
+}
+
+func TestPriorityQueue_Merge(t *testing.T) {
+	q := NewPriorityQueue[int](ascending, 4, 2)
+	other := NewPriorityQueue[int](ascending, 3)
+	item := other.Push(1)
+	q.Merge(other)
+	q.Merge(q)
+	if other.IsNotEmpty() || q.Length() != 4 {
+		t.Errorf("Merge should move every element. Got: %v and %v", q, other)
+	}
+	if other.Update(item, 5) || !q.Update(item, 5) {
+		t.Error("Merged Items should belong to the new PriorityQueue")
+	}
+	if q.String() != "[2 3 4 5]" {
+		t.Errorf("Merge should keep the heap order. Got: %v", q)
+	}
+	q.Clear()
+	if _, ok := q.Remove(item); ok || q.IsNotEmpty() {
+		t.Error("Clear should invalidate the Items")
+	}
+}
+
+func TestSafePriorityQueue(t *testing.T) {
+	q := NewSafePriorityQueue[int](ascending)
+	other := NewSafePriorityQueueFrom[int](ascending, NewSafeList(-1, -2))
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				item := q.Push(j)
+				q.Update(item, j+i)
+				q.Peek()
+			}
+			q.Merge(other)
+			other.Merge(q)
+			other.Merge(q)
+			q.Merge(other)
+		}(i)
+	}
+	wg.Wait()
+	list := q.ToList()
+	if list.Length() != 802 || !list.IsThreadSafe() || list.FirstElement() != -2 {
+		t.Errorf("SafePriorityQueue should keep every pushed element. Got: %v", list.Length())
+	}
+	for q.IsNotEmpty() {
+		q.Pop()
+	}
+}
+
+func TestSafePriorityQueue_MergeRemove(t *testing.T) {
+	q := NewSafePriorityQueue[int](ascending)
+	other := NewSafePriorityQueue[int](ascending)
+	items := make(chan *Item[int])
+	var wg sync.WaitGroup
+	var removed atomic.Int64
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		defer close(items)
+		for i := 0; i < 1000; i++ {
+			items <- other.Push(i)
+			q.Merge(other)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for item := range items {
+			// the Item may still be in other, already merged into q, or in between while Merge moves it
+			for {
+				if _, ok := other.Remove(item); ok {
+					break
+				}
+				if _, ok := q.Remove(item); ok {
+					break
+				}
+				runtime.Gosched()
+			}
+			removed.Add(1)
+		}
+	}()
+	wg.Wait()
+	if removed.Load()+int64(q.Length()+other.Length()) != 1000 {
+		t.Errorf("Merge should not lose Items. Got: %v removed, %v and %v left", removed.Load(), q.Length(), other.Length())
+	}
+}
+
+func TestSafePriorityQueue_Value(t *testing.T) {
+	q := NewSafePriorityQueue[int](ascending)
+	item := q.Push(0)
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 1; i <= 1000; i++ {
+			q.Update(item, i)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		last := 0
+		for i := 0; i < 1000; i++ {
+			v, ok := q.Value(item)
+			if !ok || v < last {
+				t.Errorf("Value should read the latest element of the Item. Got: %v, %v after %v", v, ok, last)
+			}
+			last = v
+		}
+	}()
+	wg.Wait()
+	if v, ok := q.Value(item); v != 1000 || !ok {
+		t.Errorf("Value should return the updated element. Got: %v, %v", v, ok)
+	}
+	q.Remove(item)
+	if _, ok := q.Value(item); ok {
+		t.Errorf("Value should return false for a removed Item")
+	}
+}
@@ -0,0 +1,85 @@
+package lists
+
+import (
+	"fmt"
+)
+
+// NewQueue returns a new Queue with the given elements, pushed in order: the first one is the head of the Queue
+func NewQueue[T any](elements ...T) *Queue[T] {
+	return &Queue[T]{d: NewDeque(elements...)}
+}
+
+// NewSafeQueue returns a new thread-safe Queue with the given elements, pushed in order: the first one is the head of the Queue
+func NewSafeQueue[T any](elements ...T) *Queue[T] {
+	return &Queue[T]{d: NewSafeDeque(elements...)}
+}
+
+// Queue is a first-in-first-out collection, backed by a Deque (or a SafeDeque, see NewSafeQueue).
+// Push, Pop and Peek run in amortized O(1). The zero value is an empty Queue, backed by a Deque.
+type Queue[T any] struct {
+	d deque[T]
+}
+
+// deque returns the deque backing the Queue, creating an empty Deque for the zero value.
+func (q *Queue[T]) deque() deque[T] {
+	if q.d == nil {
+		q.d = &Deque[T]{}
+	}
+	return q.d
+}
+
+// Length returns how many elements are in the Queue.
+func (q *Queue[T]) Length() int {
+	return q.deque().Length()
+}
+
+// IsEmpty returns true if there are *no* elements stored in the Queue.
+func (q *Queue[T]) IsEmpty() bool {
+	return q.Length() == 0
+}
+
+// IsNotEmpty returns true if there are elements stored in the Queue.
+func (q *Queue[T]) IsNotEmpty() bool {
+	return q.Length() > 0
+}
+
+// Push adds the given elements at the tail of the Queue, in order.
+func (q *Queue[T]) Push(elements ...T) {
+	q.deque().PushBack(elements...)
+}
+
+// Pop removes the element at the head of the Queue, and returns it and true.
+// If the Queue is empty, the zero value and false are returned.
+func (q *Queue[T]) Pop() (T, bool) {
+	return q.deque().PopFront()
+}
+
+// Peek returns the element at the head of the Queue and true, without removing it.
+// If the Queue is empty, the zero value and false are returned.
+func (q *Queue[T]) Peek() (T, bool) {
+	return q.deque().PeekFront()
+}
+
+// Clear removes all elements from the Queue, making it empty.
+func (q *Queue[T]) Clear() {
+	q.deque().Clear()
+}
+
+// ToList returns a new IList with the elements of the Queue in push order, so its first element is the head of the Queue.
+// Thread-safe Queues return a SafeList.
+func (q *Queue[T]) ToList() IList[T] {
+	if q.deque().IsThreadSafe() {
+		return NewSafeListFrom(q.deque().Elements())
+	}
+	return NewListFrom(q.deque().Elements())
+}
+
+// String returns a string representation of the Queue, in push order.
+func (q *Queue[T]) String() string {
+	return fmt.Sprint(q.deque().Elements())
+}
+
+// IsThreadSafe returns true if the Queue was created by NewSafeQueue
+func (q *Queue[T]) IsThreadSafe() bool {
+	return q.deque().IsThreadSafe()
+}
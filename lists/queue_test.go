@@ -0,0 +1,59 @@
+package lists
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestQueue(t *testing.T) {
+	q := NewQueue(1, 2)
+	q.Push(3, 4)
+	head, _ := q.Peek()
+	popped, ok := q.Pop()
+	if head != 1 || popped != 1 || !ok || q.String() != "[2 3 4]" || q.Length() != 3 {
+		t.Errorf("Queue should pop the first pushed element. Got: %v", q)
+	}
+	if list := q.ToList(); list.Shift().String() != "[3 4]" || list.IsThreadSafe() {
+		t.Errorf("Queue.ToList should keep the push order. Got: %v", list)
+	}
+	q.Clear()
+	if v, ok := q.Pop(); ok || v != 0 || q.IsNotEmpty() {
+		t.Errorf("Pop should be false when empty. Got: %v, %v", v, ok)
+	}
+	if _, ok := q.Peek(); ok {
+		t.Error("Peek should be false when empty")
+	}
+}
+
+func TestQueue_ZeroValue(t *testing.T) {
+	var q Queue[int]
+	if q.Length() != 0 || q.IsThreadSafe() || q.String() != "[]" {
+		t.Errorf("The zero value should be an empty Queue. Got: %v", q.String())
+	}
+	q.Push(1, 2)
+	if head, ok := q.Pop(); head != 1 || !ok || q.ToList().String() != "[2]" {
+		t.Errorf("The zero value should be usable. Got: %v, %v and %v", head, ok, q.String())
+	}
+}
+
+func TestSafeQueue(t *testing.T) {
+	q := NewSafeQueue[int]()
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				q.Push(j)
+			}
+		}()
+	}
+	wg.Wait()
+	popped := 0
+	for _, ok := q.Pop(); ok; _, ok = q.Pop() {
+		popped++
+	}
+	if popped != 800 || !q.IsThreadSafe() || q.IsNotEmpty() {
+		t.Errorf("SafeQueue should keep every pushed element. Got: %v", popped)
+	}
+}
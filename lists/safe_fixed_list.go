@@ -0,0 +1,164 @@
+package lists
+
+// SafeFixedList is a fixed-capacity and thread-safe implementation of IList.
+// It behaves as a SafeList backed by a FixedList, so it must be created with NewSafeFixedList: its zero value is not usable.
+type SafeFixedList[T any] struct {
+	SafeList[T]
+}
+
+// NewSafeFixedList returns a new SafeFixedList with the given capacity and elements
+// If capacity is not greater than zero, panics with ErrInvalidSize.
+func NewSafeFixedList[T any](capacity int, elements ...T) *SafeFixedList[T] {
+	s := &SafeFixedList[T]{}
+	s.l = NewFixedList(capacity, elements...)
+	return s
+}
+
+// NewSafeFixedListFrom returns a new SafeFixedList with the given capacity and slice
+func NewSafeFixedListFrom[T any](capacity int, elements []T) *SafeFixedList[T] {
+	return NewSafeFixedList(capacity, elements...)
+}
+
+func (s *SafeFixedList[T]) fixed() *FixedList[T] {
+	return s.l.(*FixedList[T])
+}
+
+// Capacity returns how many elements the SafeFixedList is able to store.
+func (s *SafeFixedList[T]) Capacity() int {
+	return read[int, T](&s.SafeList, func() int {
+		return s.fixed().Capacity()
+	})
+}
+
+// Remaining returns how many elements may still be pushed before the SafeFixedList is full.
+func (s *SafeFixedList[T]) Remaining() int {
+	return read[int, T](&s.SafeList, func() int {
+		return s.fixed().Remaining()
+	})
+}
+
+// IsFull returns true if the SafeFixedList has no room left for new elements.
+func (s *SafeFixedList[T]) IsFull() bool {
+	return read[bool, T](&s.SafeList, func() bool {
+		return s.fixed().IsFull()
+	})
+}
+
+// OnOverflow sets the OverflowPolicy used by Push when the SafeFixedList capacity is exceeded, and then returns itself.
+func (s *SafeFixedList[T]) OnOverflow(policy OverflowPolicy) *SafeFixedList[T] {
+	s.self(func() any {
+		return s.fixed().OnOverflow(policy)
+	})
+	return s
+}
+
+// TryPush add the given elements in the SafeFixedList if all of them fit in it.
+// Otherwise, the SafeFixedList is kept unaltered and ErrCapacityExceeded is returned, regardless of the OverflowPolicy.
+func (s *SafeFixedList[T]) TryPush(elements ...T) error {
+	return protect[error, T](&s.SafeList, func() error {
+		if err := s.fixed().TryPush(elements...); err != nil {
+			return err
+		}
+		s.publish(elements)
+		return nil
+	})
+}
+
+// Clone returns an identical SafeFixedList from the original, with the same capacity and OverflowPolicy.
+func (s *SafeFixedList[T]) Clone() IList[T] {
+	cloned := &SafeFixedList[T]{}
+	cloned.l = read[IList[T], T](&s.SafeList, func() IList[T] {
+		return s.l.Clone()
+	})
+	return cloned
+}
+
+// IsDynamicallySized returns false, as SafeFixedList is a fixed-capacity implementation of IList
+func (s *SafeFixedList[T]) IsDynamicallySized() bool {
+	return false
+}
+
+// Push add the given elements in the SafeFixedList, and then returns itself.
+// If the elements do not fit in the SafeFixedList, the OverflowPolicy is applied.
+func (s *SafeFixedList[T]) Push(elements ...T) IList[T] {
+	s.SafeList.Push(elements...)
+	return s
+}
+
+// Pop removes the last element from the SafeFixedList and returns itself.
+func (s *SafeFixedList[T]) Pop() IList[T] {
+	s.SafeList.Pop()
+	return s
+}
+
+// TryPop removes the last element from the SafeFixedList and returns itself.
+// If SafeFixedList is empty (see IsEmpty), it is kept unaltered and ErrEmpty is returned.
+func (s *SafeFixedList[T]) TryPop() (IList[T], error) {
+	_, err := s.SafeList.TryPop()
+	return s, err
+}
+
+// Shift removes the first element from the SafeFixedList and then returns itself.
+func (s *SafeFixedList[T]) Shift() IList[T] {
+	s.SafeList.Shift()
+	return s
+}
+
+// TryShift removes the first element from the SafeFixedList and then returns itself.
+// If SafeFixedList is empty (see IsEmpty), it is kept unaltered and ErrEmpty is returned.
+func (s *SafeFixedList[T]) TryShift() (IList[T], error) {
+	_, err := s.SafeList.TryShift()
+	return s, err
+}
+
+// Set sets the given element at the given index, and then returns itself.
+func (s *SafeFixedList[T]) Set(index int, element T) IList[T] {
+	s.SafeList.Set(index, element)
+	return s
+}
+
+// TrySet sets the given element at the given index, and then returns itself.
+// If there is no element at the given index, it is kept unaltered and ErrIndexOutOfRange is returned.
+func (s *SafeFixedList[T]) TrySet(index int, element T) (IList[T], error) {
+	_, err := s.SafeList.TrySet(index, element)
+	return s, err
+}
+
+// Sort receives a Sorter function to sort its elements, and returns itself after sorted.
+// Sort is stable: elements considered equal by the Sorter keep their original order.
+func (s *SafeFixedList[T]) Sort(sorter Sorter[T]) IList[T] {
+	s.SafeList.Sort(sorter)
+	return s
+}
+
+// Clear removes all elements from the SafeFixedList, making it empty, and then returns itself.
+// The capacity is kept.
+func (s *SafeFixedList[T]) Clear() IList[T] {
+	s.SafeList.Clear()
+	return s
+}
+
+// InsertAt inserts the given elements at the given index, moving the following elements forward, and then returns itself.
+// If the elements do not fit in the SafeFixedList, panics with ErrCapacityExceeded, regardless of the OverflowPolicy.
+func (s *SafeFixedList[T]) InsertAt(index int, elements ...T) IList[T] {
+	s.SafeList.InsertAt(index, elements...)
+	return s
+}
+
+// RemoveAt removes the element at the given index, moving the following elements backward, and then returns itself.
+func (s *SafeFixedList[T]) RemoveAt(index int) IList[T] {
+	s.SafeList.RemoveAt(index)
+	return s
+}
+
+// RemoveRange removes all elements between the *from* and *to* indexes, and then returns itself.
+func (s *SafeFixedList[T]) RemoveRange(from, to int) IList[T] {
+	s.SafeList.RemoveRange(from, to)
+	return s
+}
+
+// RemoveWhere removes all the elements which satisfies the predicate, and then returns itself.
+func (s *SafeFixedList[T]) RemoveWhere(handler Predicate[T]) IList[T] {
+	s.SafeList.RemoveWhere(handler)
+	return s
+}
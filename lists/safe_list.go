@@ -1,14 +1,19 @@
 package lists
 
 import (
+	"context"
 	"encoding/json"
 	"sync"
 )
 
 // SafeList is a dynamically-sized and thread-safe implementation of IList.
+// Read-only methods share a read lock, so they may run concurrently. Methods which change the SafeList take an exclusive lock.
+// Callbacks (such as predicates and mappers) run while the lock is held, so they must not change the same SafeList.
+// ForEach, ForEachUntil and ForEachContext are the exception: they iterate over a Snapshot, without holding the lock.
 type SafeList[T any] struct {
-	l *List[T]
-	sync.Mutex
+	l           IList[T]
+	subscribers []*subscriber[T]
+	sync.RWMutex
 }
 
 func protect[T, U any](list *SafeList[U], exec func() T) T {
@@ -17,6 +22,12 @@ func protect[T, U any](list *SafeList[U], exec func() T) T {
 	return exec()
 }
 
+func read[T, U any](list *SafeList[U], exec func() T) T {
+	list.RLock()
+	defer list.RUnlock()
+	return exec()
+}
+
 func (s *SafeList[T]) self(exec func() any) *SafeList[T] {
 	protect[any, T](s, exec)
 	return s
@@ -34,21 +45,21 @@ func NewSafeListFrom[T any](elements []T) *SafeList[T] {
 
 // Length returns how many elements are in the SafeList.
 func (s *SafeList[T]) Length() int {
-	return protect[int, T](s, func() int {
+	return read[int, T](s, func() int {
 		return s.l.Length()
 	})
 }
 
 // IsEmpty returns true if there are *no* Elements stored in the SafeList.
 func (s *SafeList[T]) IsEmpty() bool {
-	return protect[bool, T](s, func() bool {
+	return read[bool, T](s, func() bool {
 		return s.l.IsEmpty()
 	})
 }
 
 // IsNotEmpty returns true if there are Elements stored in the SafeList.
 func (s *SafeList[T]) IsNotEmpty() bool {
-	return protect[bool, T](s, func() bool {
+	return read[bool, T](s, func() bool {
 		return s.l.IsNotEmpty()
 	})
 }
@@ -56,52 +67,89 @@ func (s *SafeList[T]) IsNotEmpty() bool {
 // At returns the pointer of the element at the given index from the SafeList.
 // If there is no element at the given index, nil will be returned.
 func (s *SafeList[T]) At(i int) *T {
-	return protect[any, T](s, func() any {
+	return read[any, T](s, func() any {
 		return s.l.At(i)
 	}).(*T)
 }
 
+// AtFromEnd returns the pointer of the element at the given offset from the end of the SafeList: AtFromEnd(0) is the last element.
+// If there is no element at the given offset, nil will be returned.
+func (s *SafeList[T]) AtFromEnd(i int) *T {
+	return read[*T, T](s, func() *T {
+		return s.l.AtFromEnd(i)
+	})
+}
+
 // ElementAt returns the element at the given index from the SafeList.
 // If there is no element at the given index, panics.
 func (s *SafeList[T]) ElementAt(i int) T {
-	return protect[T, T](s, func() T {
+	return read[T, T](s, func() T {
 		return s.l.ElementAt(i)
 	})
 }
 
+// TryElementAt returns the element at the given index from the SafeList.
+// If there is no element at the given index, ErrIndexOutOfRange is returned.
+func (s *SafeList[T]) TryElementAt(i int) (T, error) {
+	var err error
+	element := read[T, T](s, func() (element T) {
+		element, err = s.l.TryElementAt(i)
+		return
+	})
+	return element, err
+}
+
 // Elements returns a built-in slice with all elements in the SafeList.
 func (s *SafeList[T]) Elements() []T {
-	return protect[any, T](s, func() any {
+	return read[any, T](s, func() any {
 		return s.l.Elements()
 	}).([]T)
 }
 
 // Push add the given elements in the SafeList, and then returns itself.
+// The elements actually stored are also delivered to the current subscribers (see Subscribe).
 func (s *SafeList[T]) Push(elements ...T) IList[T] {
 	return s.self(func() any {
-		return s.l.Push(elements...)
+		before := s.l.Length()
+		s.l.Push(elements...)
+		if f, fixed := s.l.(*FixedList[T]); fixed {
+			elements = f.stored(before, elements)
+		}
+		s.publish(elements)
+		return s.l
 	})
 }
 
 // Clone returns an identical SafeList from the original.
 func (s *SafeList[T]) Clone() IList[T] {
-	return &SafeList[T]{l: protect[IList[T], T](s, func() IList[T] {
+	return &SafeList[T]{l: read[IList[T], T](s, func() IList[T] {
 		return s.l.Clone()
-	}).(*List[T])}
+	})}
 }
 
 // FirstElement returns the first element in the SafeList.
 // If SafeList is empty (see IsEmpty), panics
 func (s *SafeList[T]) FirstElement() T {
-	return protect[T, T](s, func() T {
+	return read[T, T](s, func() T {
 		return s.l.FirstElement()
 	})
 }
 
+// TryFirstElement returns the first element in the SafeList.
+// If SafeList is empty (see IsEmpty), ErrEmpty is returned.
+func (s *SafeList[T]) TryFirstElement() (T, error) {
+	var err error
+	element := read[T, T](s, func() (element T) {
+		element, err = s.l.TryFirstElement()
+		return
+	})
+	return element, err
+}
+
 // First returns the pointer of the first element in the SafeList.
 // If SafeList is empty (see IsEmpty), nil will be returned.
 func (s *SafeList[T]) First() *T {
-	return protect[*T, T](s, func() *T {
+	return read[*T, T](s, func() *T {
 		return s.l.First()
 	})
 }
@@ -109,15 +157,26 @@ func (s *SafeList[T]) First() *T {
 // LastElement returns the last element in the SafeList.
 // If SafeList is empty (see IsEmpty), panics.
 func (s *SafeList[T]) LastElement() T {
-	return protect[T, T](s, func() T {
+	return read[T, T](s, func() T {
 		return s.l.LastElement()
 	})
 }
 
+// TryLastElement returns the last element in the SafeList.
+// If SafeList is empty (see IsEmpty), ErrEmpty is returned.
+func (s *SafeList[T]) TryLastElement() (T, error) {
+	var err error
+	element := read[T, T](s, func() (element T) {
+		element, err = s.l.TryLastElement()
+		return
+	})
+	return element, err
+}
+
 // Last returns the pointer of the last element in the SafeList.
 // If SafeList is empty (see IsEmpty), nil will be returned.
 func (s *SafeList[T]) Last() *T {
-	return protect[*T, T](s, func() *T {
+	return read[*T, T](s, func() *T {
 		return s.l.Last()
 	})
 }
@@ -125,7 +184,7 @@ func (s *SafeList[T]) Last() *T {
 // FirstIndexWhere returns the index of the first element which satisfies the predicate.
 // If no element satisfies the predicate, -1 will be returned.
 func (s *SafeList[T]) FirstIndexWhere(handler Predicate[T]) int {
-	return protect[int, T](s, func() int {
+	return read[int, T](s, func() int {
 		return s.l.FirstIndexWhere(handler)
 	})
 }
@@ -133,7 +192,7 @@ func (s *SafeList[T]) FirstIndexWhere(handler Predicate[T]) int {
 // FirstWhere returns the pointer of the first element which satisfies the predicate.
 // If no element satisfies the predicate, nil will be returned.
 func (s *SafeList[T]) FirstWhere(handler Predicate[T]) *T {
-	return protect[*T, T](s, func() *T {
+	return read[*T, T](s, func() *T {
 		return s.l.FirstWhere(handler)
 	})
 }
@@ -141,15 +200,26 @@ func (s *SafeList[T]) FirstWhere(handler Predicate[T]) *T {
 // FirstElementWhere returns the first element which satisfies the predicate.
 // If no element satisfies the predicate, panics.
 func (s *SafeList[T]) FirstElementWhere(handler Predicate[T]) T {
-	return protect[T, T](s, func() T {
+	return read[T, T](s, func() T {
 		return s.l.FirstElementWhere(handler)
 	})
 }
 
+// TryFirstElementWhere returns the first element which satisfies the predicate.
+// If no element satisfies the predicate, ErrNotFound is returned.
+func (s *SafeList[T]) TryFirstElementWhere(handler Predicate[T]) (T, error) {
+	var err error
+	element := read[T, T](s, func() (element T) {
+		element, err = s.l.TryFirstElementWhere(handler)
+		return
+	})
+	return element, err
+}
+
 // LastIndexWhere returns the index of the last element which satisfies the predicate.
 // If no element satisfies the predicate, -1 will be returned.
 func (s *SafeList[T]) LastIndexWhere(handler Predicate[T]) int {
-	return protect[int, T](s, func() int {
+	return read[int, T](s, func() int {
 		return s.l.LastIndexWhere(handler)
 	})
 }
@@ -157,7 +227,7 @@ func (s *SafeList[T]) LastIndexWhere(handler Predicate[T]) int {
 // LastWhere returns the pointer of the last element which satisfies the predicate.
 // If no element satisfies the predicate, nil will be returned.
 func (s *SafeList[T]) LastWhere(handler Predicate[T]) *T {
-	return protect[*T, T](s, func() *T {
+	return read[*T, T](s, func() *T {
 		return s.l.LastWhere(handler)
 	})
 }
@@ -165,15 +235,26 @@ func (s *SafeList[T]) LastWhere(handler Predicate[T]) *T {
 // LastElementWhere returns the last element which satisfies the predicate.
 // If no element satisfies the predicate, panics.
 func (s *SafeList[T]) LastElementWhere(handler Predicate[T]) T {
-	return protect[T, T](s, func() T {
+	return read[T, T](s, func() T {
 		return s.l.LastElementWhere(handler)
 	})
 }
 
+// TryLastElementWhere returns the last element which satisfies the predicate.
+// If no element satisfies the predicate, ErrNotFound is returned.
+func (s *SafeList[T]) TryLastElementWhere(handler Predicate[T]) (T, error) {
+	var err error
+	element := read[T, T](s, func() (element T) {
+		element, err = s.l.TryLastElementWhere(handler)
+		return
+	})
+	return element, err
+}
+
 // IndexWhere returns a List[int] for all element index which satisfies the predicate.
 // If no element satisfies the predicate, an empty List will be returned.
 func (s *SafeList[T]) IndexWhere(handler Predicate[T]) IList[int] {
-	return protect[IList[int], T](s, func() IList[int] {
+	return read[IList[int], T](s, func() IList[int] {
 		return s.l.IndexWhere(handler)
 	})
 }
@@ -181,14 +262,14 @@ func (s *SafeList[T]) IndexWhere(handler Predicate[T]) IList[int] {
 // Where returns a List with all the elements which satisfies the predicate.
 // If no element satisfies the predicate, an empty List will be returned.
 func (s *SafeList[T]) Where(handler Predicate[T]) IList[T] {
-	return protect[IList[T], T](s, func() IList[T] {
+	return read[IList[T], T](s, func() IList[T] {
 		return s.l.Where(handler)
 	})
 }
 
 // HashMap iterates over the element of the SafeList calling Mapper, and return a new List with the results.
 func (s *SafeList[T]) Map(handler Mapper[T]) IList[any] {
-	return protect[IList[any], T](s, func() IList[any] {
+	return read[IList[any], T](s, func() IList[any] {
 		return s.l.Map(handler)
 	})
 }
@@ -196,75 +277,186 @@ func (s *SafeList[T]) Map(handler Mapper[T]) IList[any] {
 // Reduce executes the Reducer for each element from the list with the given accumulator, and each result will be the accumulator for the next.
 // The final result will be returned.
 func (s *SafeList[T]) Reduce(reducer Reducer[T], accumulator any) any {
-	return protect[any, T](s, func() any {
+	return read[any, T](s, func() any {
 		return s.l.Reduce(reducer, accumulator)
 	})
 }
 
 // Every returns true if every element in the IList satisfies the predicate.
 func (s *SafeList[T]) Every(handler Predicate[T]) bool {
-	return protect[bool, T](s, func() bool {
+	return read[bool, T](s, func() bool {
 		return s.l.Every(handler)
 	})
 }
 
 // Some returns true if at least one element in the IList satisfies the predicate.
 func (s *SafeList[T]) Some(handler Predicate[T]) bool {
-	return protect[bool, T](s, func() bool {
+	return read[bool, T](s, func() bool {
 		return s.l.Some(handler)
 	})
 }
 
 // None returns true no element in the IList satisfy the predicate.
 func (s *SafeList[T]) None(handler Predicate[T]) bool {
-	return protect[bool, T](s, func() bool {
+	return read[bool, T](s, func() bool {
 		return s.l.None(handler)
 	})
 }
 
+// ForEach calls the Consumer with each element of the IList and its index, in order.
+// It iterates over a Snapshot, so the callback may use the SafeList, and does not see its changes.
+func (s *SafeList[T]) ForEach(handler Consumer[T]) {
+	s.snapshot().ForEach(handler)
+}
+
+// ForEachUntil calls the IndexedPredicate with each element of the IList and its index, in order, until it returns true.
+// It iterates over a Snapshot, so the callback may use the SafeList, and does not see its changes.
+func (s *SafeList[T]) ForEachUntil(handler IndexedPredicate[T]) {
+	s.snapshot().ForEachUntil(handler)
+}
+
+// ForEachContext calls the Consumer with each element of the IList and its index, in order, checking the context before each call.
+// Once the context is done, it stops and returns the context error.
+// It iterates over a Snapshot, so the callback may use the SafeList, and does not see its changes.
+func (s *SafeList[T]) ForEachContext(ctx context.Context, handler Consumer[T]) error {
+	return s.snapshot().ForEachContext(ctx, handler)
+}
+
 // Pop removes the last element from the IList and returns itself.
+// If SafeList is empty (see IsEmpty), panics.
 func (s *SafeList[T]) Pop() IList[T] {
 	return s.self(func() any {
 		return s.l.Pop()
 	})
 }
 
+// TryPop removes the last element from the IList and returns itself.
+// If SafeList is empty (see IsEmpty), it is kept unaltered and ErrEmpty is returned.
+func (s *SafeList[T]) TryPop() (IList[T], error) {
+	return s, protect[error, T](s, func() error {
+		_, err := s.l.TryPop()
+		return err
+	})
+}
+
 // Shift removes the first element from the IList and then returns itself.
+// If SafeList is empty (see IsEmpty), panics.
 func (s *SafeList[T]) Shift() IList[T] {
 	return s.self(func() any {
 		return s.l.Shift()
 	})
 }
 
+// TryShift removes the first element from the IList and then returns itself.
+// If SafeList is empty (see IsEmpty), it is kept unaltered and ErrEmpty is returned.
+func (s *SafeList[T]) TryShift() (IList[T], error) {
+	return s, protect[error, T](s, func() error {
+		_, err := s.l.TryShift()
+		return err
+	})
+}
+
 // Set sets the given element at the given index, and then returns itself.
+// If there is no element at the given index, panics.
 func (s *SafeList[T]) Set(index int, element T) IList[T] {
 	return s.self(func() any {
 		return s.l.Set(index, element)
 	})
 }
 
+// TrySet sets the given element at the given index, and then returns itself.
+// If there is no element at the given index, it is kept unaltered and ErrIndexOutOfRange is returned.
+func (s *SafeList[T]) TrySet(index int, element T) (IList[T], error) {
+	return s, protect[error, T](s, func() error {
+		_, err := s.l.TrySet(index, element)
+		return err
+	})
+}
+
+// InsertAt inserts the given elements at the given index, moving the following elements forward, and then returns itself.
+// The index may be equal to Length, to insert at the end. Otherwise, if there is no element at the given index, panics.
+func (s *SafeList[T]) InsertAt(index int, elements ...T) IList[T] {
+	return s.self(func() any {
+		return s.l.InsertAt(index, elements...)
+	})
+}
+
+// RemoveAt removes the element at the given index, moving the following elements backward, and then returns itself.
+// If there is no element at the given index, panics.
+func (s *SafeList[T]) RemoveAt(index int) IList[T] {
+	return s.self(func() any {
+		return s.l.RemoveAt(index)
+	})
+}
+
+// RemoveRange removes all elements between the *from* and *to* indexes, and then returns itself.
+// If the interval is not within the SafeList bounds, panics.
+func (s *SafeList[T]) RemoveRange(from, to int) IList[T] {
+	return s.self(func() any {
+		return s.l.RemoveRange(from, to)
+	})
+}
+
+// Splice removes deleteCount elements starting at the *start* index, inserts the given elements in their place,
+// and returns a new List with the removed elements. deleteCount is limited to the elements available after start.
+// The start index may be equal to Length, to insert at the end. Otherwise, if there is no element at the given index, panics.
+func (s *SafeList[T]) Splice(start, deleteCount int, elements ...T) IList[T] {
+	return protect[IList[T], T](s, func() IList[T] {
+		return s.l.Splice(start, deleteCount, elements...)
+	})
+}
+
+// RemoveWhere removes all the elements which satisfies the predicate, and then returns itself.
+func (s *SafeList[T]) RemoveWhere(handler Predicate[T]) IList[T] {
+	return s.self(func() any {
+		return s.l.RemoveWhere(handler)
+	})
+}
+
 // Interval returns a new List with all elements between the *from* and *to* indexes.
+// If the interval is not within the SafeList bounds, panics.
 func (s *SafeList[T]) Interval(from, to int) IList[T] {
-	return protect[IList[T], T](s, func() IList[T] {
+	return read[IList[T], T](s, func() IList[T] {
 		return s.l.Interval(from, to)
 	})
 }
 
+// TryInterval returns a new List with all elements between the *from* and *to* indexes.
+// If the interval is not within the SafeList bounds, ErrIndexOutOfRange is returned.
+func (s *SafeList[T]) TryInterval(from, to int) (IList[T], error) {
+	var err error
+	element := read[IList[T], T](s, func() (element IList[T]) {
+		element, err = s.l.TryInterval(from, to)
+		return
+	})
+	return element, err
+}
+
+// Slice returns a new List with the elements from the *from* index (inclusive) to the *to* index (exclusive), taking every *step* element.
+// Negative indexes are counted from the end of the SafeList (-1 is the last element), and out-of-range bounds are clamped.
+// A negative step walks backwards, from *from* down to *to*. A zero step panics with ErrZeroStep.
+func (s *SafeList[T]) Slice(from, to, step int) IList[T] {
+	return read[IList[T], T](s, func() IList[T] {
+		return s.l.Slice(from, to, step)
+	})
+}
+
 // String returns a string representation of the SafeList.
 func (s *SafeList[T]) String() string {
-	return protect[string, T](s, func() string {
+	return read[string, T](s, func() string {
 		return s.l.String()
 	})
 }
 
 // Join returns the string representation of each element in the IList, separated by the given separator
 func (s *SafeList[T]) Join(separator string) string {
-	return protect[string, T](s, func() string {
+	return read[string, T](s, func() string {
 		return s.l.Join(separator)
 	})
 }
 
 // Sort receives a Sorter function to sort its elements, and returns itself after sorted.
+// Sort is stable: elements considered equal by the Sorter keep their original order.
 func (s *SafeList[T]) Sort(sorter Sorter[T]) IList[T] {
 	return s.self(func() any {
 		return s.l.Sort(sorter)
@@ -288,6 +480,61 @@ func (s *SafeList[T]) IsThreadSafe() bool {
 	return true
 }
 
+// Snapshot returns a point-in-time copy of the SafeList elements, as an ImmutableList.
+// The copy is detached from the SafeList: later changes on the SafeList are not reflected on it, and it can not be changed itself.
+// It is meant for long iterations, which would otherwise hold the SafeList lock, and may be shared between goroutines.
+func (s *SafeList[T]) Snapshot() *ImmutableList[T] {
+	return NewImmutableList(s.snapshot().Elements()...)
+}
+
+// snapshot returns a point-in-time copy of the SafeList elements, as a List, to be iterated over without holding the lock.
+func (s *SafeList[T]) snapshot() *List[T] {
+	return read[*List[T], T](s, func() *List[T] {
+		return NewList(s.l.Elements()...)
+	})
+}
+
+// Subscribe returns a channel which receives the elements pushed to the SafeList after the subscription, in push order.
+// Only stored elements are delivered: elements discarded by a SafeFixedList (see OverflowDiscard) are not,
+// and removals, such as Pop or the evictions of a SafeFixedList (see OverflowEvict), are never reported.
+// Push never waits for subscribers: each one has its own queue, so a slow subscriber only delays its own deliveries.
+// Once the context is done, the subscription is cancelled and the channel is closed, dropping any element not yet received.
+// Clones (see Clone) do not share the subscriptions.
+func (s *SafeList[T]) Subscribe(ctx context.Context) <-chan T {
+	sub := newSubscriber[T]()
+	s.self(func() any {
+		s.subscribers = append(s.subscribers, sub)
+		return nil
+	})
+	ch := make(chan T)
+	go func() {
+		defer close(ch)
+		sub.deliver(ctx, ch)
+		s.unsubscribe(sub)
+	}()
+	return ch
+}
+
+// publish delivers the given pushed elements to the current subscribers. It must be called while locked.
+func (s *SafeList[T]) publish(elements []T) {
+	for _, sub := range s.subscribers {
+		sub.enqueue(elements)
+	}
+}
+
+// unsubscribe removes the given subscriber, so it no longer receives pushed elements.
+func (s *SafeList[T]) unsubscribe(sub *subscriber[T]) {
+	s.self(func() any {
+		for i, v := range s.subscribers {
+			if v == sub {
+				s.subscribers = append(s.subscribers[:i], s.subscribers[i+1:]...)
+				break
+			}
+		}
+		return nil
+	})
+}
+
 func (s *SafeList[T]) UnmarshalJSON(data []byte) error {
 	return protect[error, T](s, func() error {
 		return json.Unmarshal(data, s.l)
@@ -295,7 +542,7 @@ func (s *SafeList[T]) UnmarshalJSON(data []byte) error {
 }
 
 func (s *SafeList[T]) MarshalJSON() (data []byte, err error) {
-	protect[any, T](s, func() any {
+	read[any, T](s, func() any {
 		data, err = json.Marshal(s.l)
 		return err
 	})
@@ -0,0 +1,105 @@
+package lists
+
+import (
+	"sync"
+	"testing"
+)
+
+// exclusiveList guards a List with a plain sync.Mutex, as SafeList used to, to compare read-side contention.
+type exclusiveList[T any] struct {
+	l *List[T]
+	sync.Mutex
+}
+
+func (e *exclusiveList[T]) Length() int {
+	e.Lock()
+	defer e.Unlock()
+	return e.l.Length()
+}
+
+func (e *exclusiveList[T]) Some(handler Predicate[T]) bool {
+	e.Lock()
+	defer e.Unlock()
+	return e.l.Some(handler)
+}
+
+func readers() *List[int] {
+	list := NewList[int]()
+	for i := 0; i < 1000; i++ {
+		list.Push(i)
+	}
+	return list
+}
+
+func never(int) bool {
+	return false
+}
+
+func TestSafeList_Snapshot(t *testing.T) {
+	list := NewSafeList(1, 2, 3)
+	snapshot := list.Snapshot()
+	list.Push(4)
+	changed := snapshot.Set(0, 10)
+	if snapshot.Join(",") != "1,2,3" || changed.Join(",") != "10,2,3" || list.Join(",") != "1,2,3,4" {
+		t.Errorf("Snapshot should be detached from the SafeList, and immutable. Got: %v and %v", snapshot, list)
+	}
+}
+
+func TestSafeList_ConcurrentReads(t *testing.T) {
+	list := NewSafeList(1, 2, 3)
+	var wg sync.WaitGroup
+	list.RLock()
+	wg.Add(1)
+	go func() {
+		// would deadlock if reads were exclusive, as the read lock is still held
+		list.Length()
+		wg.Done()
+	}()
+	wg.Wait()
+	list.RUnlock()
+}
+
+func BenchmarkSafeList_ConcurrentReads(b *testing.B) {
+	list := NewSafeListFrom(readers().Elements())
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			list.Some(never)
+			list.Length()
+		}
+	})
+}
+
+func BenchmarkExclusiveList_ConcurrentReads(b *testing.B) {
+	list := &exclusiveList[int]{l: readers()}
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			list.Some(never)
+			list.Length()
+		}
+	})
+}
+
+func TestSafeList_ForEach(t *testing.T) {
+	list := NewSafeList(1, 2, 3)
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				list.Push(j)
+				list.ForEach(func(v int, i int) {
+					// callbacks may safely use the SafeList, as it is not locked during the iteration
+					list.Length()
+				})
+				list.ForEachUntil(func(v int, i int) bool {
+					return list.At(i) == nil
+				})
+			}
+		}()
+	}
+	wg.Wait()
+	if list.Length() != 403 {
+		t.Errorf("SafeList.ForEach should not lose concurrent pushes. Got: %v", list.Length())
+	}
+}
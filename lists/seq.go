@@ -0,0 +1,177 @@
+package lists
+
+// Seq is a lazy sequence of elements.
+// Operations such as Where, Map and Take only describe the sequence, and no element is evaluated until a
+// terminal operation (such as ToList, First or Count) is called. Elements flow one by one through the whole
+// chain, so no intermediate collection is built, and evaluation stops as soon as the terminal operation has its result.
+//
+// A Seq calls yield for each of its elements, in order, until yield returns false.
+type Seq[T any] func(yield func(T) bool)
+
+// NewSeq returns a new Seq over the elements of the given IList.
+// The IList is read each time a terminal operation is called. Thread-safe implementations are read through a consistent copy.
+func NewSeq[T any](list IList[T]) Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range elementsOf(list) {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// NewSeqFrom returns a new Seq over the given slice
+func NewSeqFrom[T any](elements []T) Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range elements {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// MapSeq returns a Seq with the results of calling the TypeMapper on each element of the given Seq.
+// It is the typed counterpart of Seq.Map.
+func MapSeq[F, T any](seq Seq[F], mapper TypeMapper[F, T]) Seq[T] {
+	return func(yield func(T) bool) {
+		seq(func(v F) bool {
+			return yield(mapper(v))
+		})
+	}
+}
+
+// Where returns a Seq with only the elements which satisfies the predicate.
+func (s Seq[T]) Where(handler Predicate[T]) Seq[T] {
+	return func(yield func(T) bool) {
+		s(func(v T) bool {
+			return !handler(v) || yield(v)
+		})
+	}
+}
+
+// Map returns a Seq with the results of calling the Mapper on each element. See MapSeq for a typed alternative.
+func (s Seq[T]) Map(handler Mapper[T]) Seq[any] {
+	return MapSeq(s, TypeMapper[T, any](handler))
+}
+
+// Take returns a Seq with, at most, the first n elements.
+func (s Seq[T]) Take(n int) Seq[T] {
+	return func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+		taken := 0
+		s(func(v T) bool {
+			taken++
+			return yield(v) && taken < n
+		})
+	}
+}
+
+// Skip returns a Seq without the first n elements.
+func (s Seq[T]) Skip(n int) Seq[T] {
+	return func(yield func(T) bool) {
+		skipped := 0
+		s(func(v T) bool {
+			if skipped < n {
+				skipped++
+				return true
+			}
+			return yield(v)
+		})
+	}
+}
+
+// TakeWhile returns a Seq with the leading elements which satisfies the predicate.
+// It stops at the first element which does not satisfy it.
+func (s Seq[T]) TakeWhile(handler Predicate[T]) Seq[T] {
+	return func(yield func(T) bool) {
+		s(func(v T) bool {
+			return handler(v) && yield(v)
+		})
+	}
+}
+
+// SkipWhile returns a Seq without the leading elements which satisfies the predicate.
+func (s Seq[T]) SkipWhile(handler Predicate[T]) Seq[T] {
+	return func(yield func(T) bool) {
+		skipping := true
+		s(func(v T) bool {
+			if skipping && handler(v) {
+				return true
+			}
+			skipping = false
+			return yield(v)
+		})
+	}
+}
+
+// Distinct returns a Seq without repeated elements, keeping the first occurrence of each.
+// Elements are compared as map keys, so T must hold comparable values, otherwise it panics. See DistinctBy.
+func (s Seq[T]) Distinct() Seq[T] {
+	return s.DistinctBy(func(v T) any {
+		return v
+	})
+}
+
+// DistinctBy returns a Seq without elements whose key was already seen, keeping the first occurrence of each key.
+// The key must be comparable, otherwise it panics.
+func (s Seq[T]) DistinctBy(key TypeMapper[T, any]) Seq[T] {
+	return func(yield func(T) bool) {
+		seen := map[any]struct{}{}
+		s(func(v T) bool {
+			k := key(v)
+			if _, has := seen[k]; has {
+				return true
+			}
+			seen[k] = struct{}{}
+			return yield(v)
+		})
+	}
+}
+
+// ToList evaluates the Seq and returns a new List with its elements.
+func (s Seq[T]) ToList() IList[T] {
+	list := NewList[T]()
+	s(func(v T) bool {
+		list.Push(v)
+		return true
+	})
+	return list
+}
+
+// First evaluates the Seq until its first element, and returns its pointer.
+// If the Seq is empty, nil will be returned.
+func (s Seq[T]) First() (first *T) {
+	s(func(v T) bool {
+		first = &v
+		return false
+	})
+	return
+}
+
+// Count evaluates the Seq and returns how many elements it has.
+func (s Seq[T]) Count() (count int) {
+	s(func(T) bool {
+		count++
+		return true
+	})
+	return
+}
+
+// Some evaluates the Seq until an element satisfies the predicate, and returns true if any does.
+func (s Seq[T]) Some(handler Predicate[T]) (some bool) {
+	s(func(v T) bool {
+		some = handler(v)
+		return !some
+	})
+	return
+}
+
+// Every evaluates the Seq until an element does not satisfy the predicate, and returns true if every element does.
+func (s Seq[T]) Every(handler Predicate[T]) bool {
+	return !s.Some(func(v T) bool {
+		return !handler(v)
+	})
+}
@@ -0,0 +1,65 @@
+package lists
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestSeq_Lazy(t *testing.T) {
+	evaluated := 0
+	seq := NewSeq[int](NewList(1, 2, 3, 4, 5, 6, 7, 8, 9, 10)).Where(func(v int) bool {
+		evaluated++
+		return v%2 == 0
+	})
+	if evaluated != 0 {
+		t.Error("Seq should not be evaluated before a terminal operation")
+	}
+	first := seq.Map(func(v int) any {
+		return v * 10
+	}).First()
+	if first == nil || *first != 20 {
+		t.Errorf("Seq.First: expected 20. Got: %v", first)
+	}
+	if evaluated != 2 {
+		t.Errorf("Seq.First should stop at the first result. Evaluated %v elements", evaluated)
+	}
+}
+
+func TestSeq_Chain(t *testing.T) {
+	list := NewSeqFrom([]int{5, 1, 2, 2, 3, 4, 1, 6, 7, 8}).
+		SkipWhile(func(v int) bool {
+			return v > 2
+		}).
+		Distinct().
+		Skip(1).
+		TakeWhile(func(v int) bool {
+			return v < 8
+		}).
+		Take(4).
+		ToList()
+	if list.String() != fmt.Sprint([]int{2, 3, 4, 6}) {
+		t.Errorf("Seq chain: expected [2 3 4 6]. Got: %v", list)
+	}
+}
+
+func TestSeq_Terminal(t *testing.T) {
+	seq := NewSeq[int](NewSafeList(1, 2, 3))
+	if seq.Count() != 3 {
+		t.Error("Seq.Count should be 3")
+	}
+	if !seq.Some(func(v int) bool { return v == 2 }) || seq.Some(func(v int) bool { return v == 4 }) {
+		t.Error("Seq.Some is different from expected")
+	}
+	if !seq.Every(func(v int) bool { return v > 0 }) || seq.Every(func(v int) bool { return v > 1 }) {
+		t.Error("Seq.Every is different from expected")
+	}
+	if seq.Take(0).First() != nil {
+		t.Error("Seq.First on empty Seq should be nil")
+	}
+	strs := MapSeq(seq, func(v int) string {
+		return fmt.Sprint(v)
+	}).ToList()
+	if strs.Join("") != "123" {
+		t.Errorf("MapSeq: expected 123. Got: %v", strs.Join(""))
+	}
+}
@@ -0,0 +1,117 @@
+package lists
+
+// Distinct returns an IList without repeated elements, keeping the first occurrence of each, in order.
+// The result is of the same implementation as the given IList (see Clone), unless it has a fixed capacity (see FixedList).
+func Distinct[T comparable](list IList[T]) IList[T] {
+	return DistinctBy(list, identity[T])
+}
+
+// Union returns an IList with the distinct elements of both ILists: first the ones from a, then the ones only in b, in order.
+// The result is of the same implementation as a (see Clone), unless it has a fixed capacity (see FixedList).
+func Union[T comparable](a, b IList[T]) IList[T] {
+	return UnionBy(a, b, identity[T])
+}
+
+// Intersect returns an IList with the distinct elements of a which are also in b, in order.
+// The result is of the same implementation as a (see Clone), unless it has a fixed capacity (see FixedList).
+func Intersect[T comparable](a, b IList[T]) IList[T] {
+	return IntersectBy(a, b, identity[T])
+}
+
+// Except returns an IList with the distinct elements of a which are not in b, in order.
+// The result is of the same implementation as a (see Clone), unless it has a fixed capacity (see FixedList).
+func Except[T comparable](a, b IList[T]) IList[T] {
+	return ExceptBy(a, b, identity[T])
+}
+
+// SymmetricDifference returns an IList with the distinct elements which are in only one of the ILists:
+// first the ones from a, then the ones from b, in order.
+// The result is of the same implementation as a (see Clone), unless it has a fixed capacity (see FixedList).
+func SymmetricDifference[T comparable](a, b IList[T]) IList[T] {
+	return SymmetricDifferenceBy(a, b, identity[T])
+}
+
+// DistinctBy returns an IList without elements whose key was already seen, keeping the first occurrence of each key, in order.
+// The result is of the same implementation as the given IList (see Clone), unless it has a fixed capacity (see FixedList).
+func DistinctBy[T any, K comparable](list IList[T], key TypeMapper[T, K]) IList[T] {
+	return like(list, distinct(key, map[K]struct{}{}, elementsOf(list)))
+}
+
+// UnionBy returns an IList with the elements of both ILists with distinct keys: first the ones from a, then the ones whose key is only in b, in order.
+// The result is of the same implementation as a (see Clone), unless it has a fixed capacity (see FixedList).
+func UnionBy[T any, K comparable](a, b IList[T], key TypeMapper[T, K]) IList[T] {
+	seen := map[K]struct{}{}
+	return like(a, append(distinct(key, seen, elementsOf(a)), distinct(key, seen, elementsOf(b))...))
+}
+
+// IntersectBy returns an IList with the elements of a with distinct keys which are also keys in b, in order.
+// The result is of the same implementation as a (see Clone), unless it has a fixed capacity (see FixedList).
+func IntersectBy[T any, K comparable](a, b IList[T], key TypeMapper[T, K]) IList[T] {
+	keys := keysOf(elementsOf(b), key)
+	return like(a, distinct(key, map[K]struct{}{}, elementsOf(a), func(k K) bool {
+		_, has := keys[k]
+		return has
+	}))
+}
+
+// ExceptBy returns an IList with the elements of a with distinct keys which are not keys in b, in order.
+// The result is of the same implementation as a (see Clone), unless it has a fixed capacity (see FixedList).
+func ExceptBy[T any, K comparable](a, b IList[T], key TypeMapper[T, K]) IList[T] {
+	return like(a, distinct(key, keysOf(elementsOf(b), key), elementsOf(a)))
+}
+
+// SymmetricDifferenceBy returns an IList with the elements with distinct keys which are keys in only one of the ILists:
+// first the ones from a, then the ones from b, in order.
+// The result is of the same implementation as a (see Clone), unless it has a fixed capacity (see FixedList).
+func SymmetricDifferenceBy[T any, K comparable](a, b IList[T], key TypeMapper[T, K]) IList[T] {
+	fromA, fromB := elementsOf(a), elementsOf(b)
+	onlyA := distinct(key, keysOf(fromB, key), fromA)
+	onlyB := distinct(key, keysOf(fromA, key), fromB)
+	return like(a, append(onlyA, onlyB...))
+}
+
+// distinct returns the elements whose key was not seen yet, in order, adding their keys to seen.
+// Optional filters must all accept a key for its element to be returned.
+func distinct[T any, K comparable](key TypeMapper[T, K], seen map[K]struct{}, elements []T, filters ...func(K) bool) []T {
+	var kept []T
+elements:
+	for _, v := range elements {
+		k := key(v)
+		if _, has := seen[k]; has {
+			continue
+		}
+		for _, filter := range filters {
+			if !filter(k) {
+				continue elements
+			}
+		}
+		seen[k] = struct{}{}
+		kept = append(kept, v)
+	}
+	return kept
+}
+
+// keysOf returns the set of keys of all the given elements.
+func keysOf[T any, K comparable](elements []T, key TypeMapper[T, K]) map[K]struct{} {
+	keys := map[K]struct{}{}
+	for _, v := range elements {
+		keys[key(v)] = struct{}{}
+	}
+	return keys
+}
+
+// like returns a new IList of the same implementation as the given one (see Clone), with the given elements.
+// Fixed-capacity implementations may not have room for them, so a List, or a SafeList if thread-safe, is returned instead.
+func like[T any](list IList[T], elements []T) IList[T] {
+	if _, fixed := list.(interface{ Capacity() int }); fixed {
+		if list.IsThreadSafe() {
+			return NewSafeListFrom(elements)
+		}
+		return NewListFrom(elements)
+	}
+	return list.Clone().Clear().Push(elements...)
+}
+
+func identity[T any](v T) T {
+	return v
+}
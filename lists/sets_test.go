@@ -0,0 +1,72 @@
+package lists
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDistinct(t *testing.T) {
+	distinct := Distinct[int](NewSafeList(3, 1, 3, 2, 1))
+	if distinct.Join(",") != "3,1,2" {
+		t.Errorf("Distinct: expected 3,1,2. Got: %v", distinct.Join(","))
+	}
+	if _, is := distinct.(*SafeList[int]); !is {
+		t.Errorf("Distinct should keep the SafeList implementation. Got: %T", distinct)
+	}
+}
+
+func TestSetAlgebra(t *testing.T) {
+	a, b := NewList(1, 2, 2, 3, 4), NewList(5, 4, 3, 3)
+	cases := map[string]IList[int]{
+		"1,2,3,4,5": Union[int](a, b),
+		"3,4":       Intersect[int](a, b),
+		"1,2":       Except[int](a, b),
+		"1,2,5":     SymmetricDifference[int](a, b),
+	}
+	for expected, result := range cases {
+		if result.Join(",") != expected {
+			t.Errorf("expected %v. Got: %v", expected, result.Join(","))
+		}
+		if _, is := result.(*List[int]); !is {
+			t.Errorf("set operations should keep the List implementation. Got: %T", result)
+		}
+	}
+	if a.Join(",") != "1,2,2,3,4" || b.Join(",") != "5,4,3,3" {
+		t.Error("set operations should not change the given lists")
+	}
+}
+
+func TestSetAlgebraBy(t *testing.T) {
+	a, b := NewList("Foo", "bar", "FOO", "baz"), NewList("BAZ", "qux")
+	if distinct := DistinctBy[string](NewList("Foo", "bar", "FOO"), strings.ToLower); distinct.Join(",") != "Foo,bar" {
+		t.Errorf("DistinctBy: expected Foo,bar. Got: %v", distinct.Join(","))
+	}
+	cases := map[string]IList[string]{
+		"Foo,bar,baz,qux": UnionBy[string](a, b, strings.ToLower),
+		"baz":             IntersectBy[string](a, b, strings.ToLower),
+		"Foo,bar":         ExceptBy[string](a, b, strings.ToLower),
+		"Foo,bar,qux":     SymmetricDifferenceBy[string](a, b, strings.ToLower),
+	}
+	for expected, result := range cases {
+		if result.Join(",") != expected {
+			t.Errorf("expected %v. Got: %v", expected, result.Join(","))
+		}
+	}
+}
+
+func TestSetAlgebra_FixedCapacity(t *testing.T) {
+	union := Union[int](NewFixedList(3, 1, 2, 3), NewList(4, 5))
+	if _, is := union.(*List[int]); !is || union.Join(",") != "1,2,3,4,5" {
+		t.Errorf("Union of a FixedList should be an unbounded List. Got %T: %v", union, union)
+	}
+	safe := SymmetricDifference[int](NewSafeFixedList(2, 1, 2), NewList(3, 4, 5))
+	if _, is := safe.(*SafeList[int]); !is || safe.Join(",") != "1,2,3,4,5" {
+		t.Errorf("SymmetricDifference of a SafeFixedList should be an unbounded SafeList. Got %T: %v", safe, safe)
+	}
+	even, odd := Partition[int](NewSafeFixedList(3, 1, 2, 3), func(v int) bool {
+		return v%2 == 0
+	})
+	if _, is := odd.(*SafeList[int]); !is || even.Join(",") != "2" || odd.Join(",") != "1,3" {
+		t.Errorf("Partition of a SafeFixedList should return SafeLists. Got %T: %v and %v", odd, even, odd)
+	}
+}
@@ -0,0 +1,68 @@
+package lists
+
+// insertionThreshold is the size of the blocks sorted by insertion sort before being merged.
+const insertionThreshold = 16
+
+// stableSort sorts the given slice in place using the Sorter, with a bottom-up merge sort.
+// It runs in O(n log n) time using an auxiliary buffer of n elements, and is stable:
+// elements considered equal by the Sorter keep their original relative order.
+func stableSort[T any](elements []T, sorter Sorter[T]) {
+	n := len(elements)
+	if n < 2 {
+		return
+	}
+	for start := 0; start < n; start += insertionThreshold {
+		end := start + insertionThreshold
+		if end > n {
+			end = n
+		}
+		insertionSort(elements[start:end], sorter)
+	}
+	if n <= insertionThreshold {
+		return
+	}
+	src, dst := elements, make([]T, n)
+	for width := insertionThreshold; width < n; width *= 2 {
+		for start := 0; start < n; start += 2 * width {
+			mid, end := start+width, start+2*width
+			if mid > n {
+				mid = n
+			}
+			if end > n {
+				end = n
+			}
+			merge(src[start:mid], src[mid:end], dst[start:end], sorter)
+		}
+		src, dst = dst, src
+	}
+	if &src[0] != &elements[0] {
+		copy(elements, src)
+	}
+}
+
+// insertionSort sorts the given slice in place using the Sorter. It is stable, and fast for small slices.
+func insertionSort[T any](elements []T, sorter Sorter[T]) {
+	for i := 1; i < len(elements); i++ {
+		for j := i; j > 0 && sorter(elements[j-1], elements[j]) > 0; j-- {
+			elements[j-1], elements[j] = elements[j], elements[j-1]
+		}
+	}
+}
+
+// merge merges the sorted left and right slices into dst, which must have room for both.
+// On ties, elements from left come first, which keeps the merge stable.
+func merge[T any](left, right, dst []T, sorter Sorter[T]) {
+	i, j, k := 0, 0, 0
+	for i < len(left) && j < len(right) {
+		if sorter(right[j], left[i]) < 0 {
+			dst[k] = right[j]
+			j++
+		} else {
+			dst[k] = left[i]
+			i++
+		}
+		k++
+	}
+	k += copy(dst[k:], left[i:])
+	copy(dst[k:], right[j:])
+}
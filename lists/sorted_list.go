@@ -0,0 +1,467 @@
+package lists
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// NewSortedList returns a new SortedList ordered by the given Sorter, with the given elements
+func NewSortedList[T any](sorter Sorter[T], elements ...T) *SortedList[T] {
+	s := &SortedList[T]{l: NewList[T](), sorter: sorter}
+	s.Push(elements...)
+	return s
+}
+
+// NewSortedListFrom returns a new SortedList ordered by the given Sorter, with the elements of the given slice
+func NewSortedListFrom[T any](sorter Sorter[T], elements []T) *SortedList[T] {
+	return NewSortedList(sorter, elements...)
+}
+
+// SortedList is a thread-unsafe implementation of IList which keeps its elements ordered by a Sorter.
+// Pushed elements are inserted at their position, after any element considered equal by the Sorter,
+// so searches (see Search, LowerBound, UpperBound, Between and Contains) run in O(log n).
+//
+// Calls which would break the order are rejected: Set and TrySet with ErrUnordered, InsertAt and Splice panicking with it.
+// Sort re-sorts the elements and adopts the given Sorter. Changing an element through a pointer (see At, First, Last, ...)
+// bypasses these checks, and leaves the searches undefined if the order is broken.
+type SortedList[T any] struct {
+	l      *List[T]
+	sorter Sorter[T]
+}
+
+// Search returns the index of the first element considered equal to the given one by the Sorter, and true.
+// If there is no such element, it returns the index where it would be inserted, and false.
+func (s *SortedList[T]) Search(element T) (int, bool) {
+	i := s.LowerBound(element)
+	return i, i < s.Length() && s.sorter(s.l.Elements()[i], element) == 0
+}
+
+// LowerBound returns the index of the first element which is not less than the given one.
+// If there is no such element, Length is returned.
+func (s *SortedList[T]) LowerBound(element T) int {
+	return s.bound(func(v T) bool {
+		return s.sorter(v, element) >= 0
+	})
+}
+
+// UpperBound returns the index of the first element which is greater than the given one.
+// If there is no such element, Length is returned.
+func (s *SortedList[T]) UpperBound(element T) int {
+	return s.bound(func(v T) bool {
+		return s.sorter(v, element) > 0
+	})
+}
+
+// Between returns a new List with all elements between *from* and *to* (both inclusive), in order.
+// If *from* is greater than *to*, the List is empty.
+func (s *SortedList[T]) Between(from, to T) IList[T] {
+	start, end := s.LowerBound(from), s.UpperBound(to)
+	if start >= end {
+		return NewList[T]()
+	}
+	return NewList(s.l.Elements()[start:end]...)
+}
+
+// Contains returns true if there is an element considered equal to the given one by the Sorter.
+func (s *SortedList[T]) Contains(element T) bool {
+	_, found := s.Search(element)
+	return found
+}
+
+// Length returns how many elements are in the SortedList.
+func (s *SortedList[T]) Length() int {
+	return s.l.Length()
+}
+
+// IsEmpty returns true if there are *no* Elements stored in the SortedList.
+func (s *SortedList[T]) IsEmpty() bool {
+	return s.l.IsEmpty()
+}
+
+// IsNotEmpty returns true if there are Elements stored in the SortedList.
+func (s *SortedList[T]) IsNotEmpty() bool {
+	return s.l.IsNotEmpty()
+}
+
+// At returns the pointer of the element at the given index from the SortedList.
+// If there is no element at the given index, nil will be returned.
+func (s *SortedList[T]) At(i int) *T {
+	return s.l.At(i)
+}
+
+// AtFromEnd returns the pointer of the element at the given offset from the end of the SortedList: AtFromEnd(0) is the last element.
+// If there is no element at the given offset, nil will be returned.
+func (s *SortedList[T]) AtFromEnd(i int) *T {
+	return s.l.AtFromEnd(i)
+}
+
+// ElementAt returns the element at the given index from the SortedList.
+// If there is no element at the given index, panics.
+func (s *SortedList[T]) ElementAt(i int) T {
+	return s.l.ElementAt(i)
+}
+
+// TryElementAt returns the element at the given index from the SortedList.
+// If there is no element at the given index, ErrIndexOutOfRange is returned.
+func (s *SortedList[T]) TryElementAt(i int) (T, error) {
+	return s.l.TryElementAt(i)
+}
+
+// Elements returns a built-in slice with all elements in the SortedList.
+func (s *SortedList[T]) Elements() []T {
+	return s.l.Elements()
+}
+
+// Push inserts the given elements in the SortedList at their ordered positions, and then returns itself.
+// Elements considered equal by the Sorter are kept in the order they were pushed.
+func (s *SortedList[T]) Push(elements ...T) IList[T] {
+	if len(elements) == 1 {
+		s.l.InsertAt(s.UpperBound(elements[0]), elements[0])
+		return s
+	}
+	s.l.Push(elements...)
+	stableSort(s.l.Elements(), s.sorter)
+	return s
+}
+
+// Clone returns an identical SortedList from the original, ordered by the same Sorter.
+func (s *SortedList[T]) Clone() IList[T] {
+	return &SortedList[T]{l: NewList(s.Elements()...), sorter: s.sorter}
+}
+
+// FirstElement returns the first element in the SortedList.
+// If SortedList is empty (see IsEmpty), panics
+func (s *SortedList[T]) FirstElement() T {
+	return s.l.FirstElement()
+}
+
+// TryFirstElement returns the first element in the SortedList.
+// If SortedList is empty (see IsEmpty), ErrEmpty is returned.
+func (s *SortedList[T]) TryFirstElement() (T, error) {
+	return s.l.TryFirstElement()
+}
+
+// First returns the pointer of the first element in the SortedList.
+// If SortedList is empty (see IsEmpty), nil will be returned.
+func (s *SortedList[T]) First() *T {
+	return s.l.First()
+}
+
+// LastElement returns the last element in the SortedList.
+// If SortedList is empty (see IsEmpty), panics.
+func (s *SortedList[T]) LastElement() T {
+	return s.l.LastElement()
+}
+
+// TryLastElement returns the last element in the SortedList.
+// If SortedList is empty (see IsEmpty), ErrEmpty is returned.
+func (s *SortedList[T]) TryLastElement() (T, error) {
+	return s.l.TryLastElement()
+}
+
+// Last returns the pointer of the last element in the SortedList.
+// If SortedList is empty (see IsEmpty), nil will be returned.
+func (s *SortedList[T]) Last() *T {
+	return s.l.Last()
+}
+
+// FirstIndexWhere returns the index of the first element which satisfies the predicate.
+// If no element satisfies the predicate, -1 will be returned.
+func (s *SortedList[T]) FirstIndexWhere(handler Predicate[T]) int {
+	return s.l.FirstIndexWhere(handler)
+}
+
+// FirstWhere returns the pointer of the first element which satisfies the predicate.
+// If no element satisfies the predicate, nil will be returned.
+func (s *SortedList[T]) FirstWhere(handler Predicate[T]) *T {
+	return s.l.FirstWhere(handler)
+}
+
+// FirstElementWhere returns the first element which satisfies the predicate.
+// If no element satisfies the predicate, panics.
+func (s *SortedList[T]) FirstElementWhere(handler Predicate[T]) T {
+	return s.l.FirstElementWhere(handler)
+}
+
+// TryFirstElementWhere returns the first element which satisfies the predicate.
+// If no element satisfies the predicate, ErrNotFound is returned.
+func (s *SortedList[T]) TryFirstElementWhere(handler Predicate[T]) (T, error) {
+	return s.l.TryFirstElementWhere(handler)
+}
+
+// LastIndexWhere returns the index of the last element which satisfies the predicate.
+// If no element satisfies the predicate, -1 will be returned.
+func (s *SortedList[T]) LastIndexWhere(handler Predicate[T]) int {
+	return s.l.LastIndexWhere(handler)
+}
+
+// LastWhere returns the pointer of the last element which satisfies the predicate.
+// If no element satisfies the predicate, nil will be returned.
+func (s *SortedList[T]) LastWhere(handler Predicate[T]) *T {
+	return s.l.LastWhere(handler)
+}
+
+// LastElementWhere returns the last element which satisfies the predicate.
+// If no element satisfies the predicate, panics.
+func (s *SortedList[T]) LastElementWhere(handler Predicate[T]) T {
+	return s.l.LastElementWhere(handler)
+}
+
+// TryLastElementWhere returns the last element which satisfies the predicate.
+// If no element satisfies the predicate, ErrNotFound is returned.
+func (s *SortedList[T]) TryLastElementWhere(handler Predicate[T]) (T, error) {
+	return s.l.TryLastElementWhere(handler)
+}
+
+// IndexWhere returns a List[int] for all element index which satisfies the predicate.
+// If no element satisfies the predicate, an empty List will be returned.
+func (s *SortedList[T]) IndexWhere(handler Predicate[T]) IList[int] {
+	return s.l.IndexWhere(handler)
+}
+
+// Where returns a List with all the elements which satisfies the predicate.
+// If no element satisfies the predicate, an empty List will be returned.
+func (s *SortedList[T]) Where(handler Predicate[T]) IList[T] {
+	return s.l.Where(handler)
+}
+
+// Map iterates over the element of the SortedList calling Mapper, and return a new List with the results.
+func (s *SortedList[T]) Map(handler Mapper[T]) IList[any] {
+	return s.l.Map(handler)
+}
+
+// Reduce executes the Reducer for each element from the list with the given accumulator, and each result will be the accumulator for the next.
+// The final result will be returned.
+func (s *SortedList[T]) Reduce(reducer Reducer[T], accumulator any) any {
+	return s.l.Reduce(reducer, accumulator)
+}
+
+// Every returns true if every element in the IList satisfies the predicate.
+func (s *SortedList[T]) Every(handler Predicate[T]) bool {
+	return s.l.Every(handler)
+}
+
+// Some returns true if at least one element in the IList satisfies the predicate.
+func (s *SortedList[T]) Some(handler Predicate[T]) bool {
+	return s.l.Some(handler)
+}
+
+// None returns true no element in the IList satisfy the predicate.
+func (s *SortedList[T]) None(handler Predicate[T]) bool {
+	return s.l.None(handler)
+}
+
+// ForEach calls the Consumer with each element of the IList and its index, in order.
+func (s *SortedList[T]) ForEach(handler Consumer[T]) {
+	s.l.ForEach(handler)
+}
+
+// ForEachUntil calls the IndexedPredicate with each element of the IList and its index, in order, until it returns true.
+func (s *SortedList[T]) ForEachUntil(handler IndexedPredicate[T]) {
+	s.l.ForEachUntil(handler)
+}
+
+// ForEachContext calls the Consumer with each element of the IList and its index, in order, checking the context before each call.
+// Once the context is done, it stops and returns the context error.
+func (s *SortedList[T]) ForEachContext(ctx context.Context, handler Consumer[T]) error {
+	return s.l.ForEachContext(ctx, handler)
+}
+
+// Pop removes the last element from the IList and returns itself.
+// If SortedList is empty (see IsEmpty), panics.
+func (s *SortedList[T]) Pop() IList[T] {
+	return must(s.TryPop())
+}
+
+// TryPop removes the last element from the IList and returns itself.
+// If SortedList is empty (see IsEmpty), it is kept unaltered and ErrEmpty is returned.
+func (s *SortedList[T]) TryPop() (IList[T], error) {
+	_, err := s.l.TryPop()
+	return s, err
+}
+
+// Shift removes the first element from the IList and then returns itself.
+// If SortedList is empty (see IsEmpty), panics.
+func (s *SortedList[T]) Shift() IList[T] {
+	return must(s.TryShift())
+}
+
+// TryShift removes the first element from the IList and then returns itself.
+// If SortedList is empty (see IsEmpty), it is kept unaltered and ErrEmpty is returned.
+func (s *SortedList[T]) TryShift() (IList[T], error) {
+	_, err := s.l.TryShift()
+	return s, err
+}
+
+// Set sets the given element at the given index, and then returns itself.
+// If there is no element at the given index, or the element does not keep the order, panics.
+func (s *SortedList[T]) Set(index int, element T) IList[T] {
+	return must(s.TrySet(index, element))
+}
+
+// TrySet sets the given element at the given index, and then returns itself.
+// If there is no element at the given index, it is kept unaltered and ErrIndexOutOfRange is returned.
+// If the element does not keep the order, it is kept unaltered and ErrUnordered is returned.
+func (s *SortedList[T]) TrySet(index int, element T) (IList[T], error) {
+	if s.At(index) == nil {
+		return s, outOfRange(index, s.Length())
+	}
+	elements := s.l.Elements()
+	if (index > 0 && s.sorter(elements[index-1], element) > 0) ||
+		(index < len(elements)-1 && s.sorter(element, elements[index+1]) > 0) {
+		return s, ErrUnordered
+	}
+	elements[index] = element
+	return s, nil
+}
+
+// InsertAt inserts the given elements at the given index, moving the following elements forward, and then returns itself.
+// The index may be equal to Length, to insert at the end. Otherwise, if there is no element at the given index, panics.
+// If the elements would not be in order at the given index, panics with ErrUnordered. See Push to insert them at their positions.
+func (s *SortedList[T]) InsertAt(index int, elements ...T) IList[T] {
+	if index < 0 || index > s.Length() {
+		panic(outOfRange(index, s.Length()))
+	}
+	s.ensureOrdered(s.l.Elements()[:index], elements, s.l.Elements()[index:])
+	s.l.InsertAt(index, elements...)
+	return s
+}
+
+// RemoveAt removes the element at the given index, moving the following elements backward, and then returns itself.
+// If there is no element at the given index, panics.
+func (s *SortedList[T]) RemoveAt(index int) IList[T] {
+	s.l.RemoveAt(index)
+	return s
+}
+
+// RemoveRange removes all elements between the *from* and *to* indexes, and then returns itself.
+// If the interval is not within the SortedList bounds, panics.
+func (s *SortedList[T]) RemoveRange(from, to int) IList[T] {
+	s.l.RemoveRange(from, to)
+	return s
+}
+
+// Splice removes deleteCount elements starting at the *start* index, inserts the given elements in their place,
+// and returns a new List with the removed elements. deleteCount is limited to the elements available after start.
+// The start index may be equal to Length, to insert at the end. Otherwise, if there is no element at the given index, panics.
+// If the inserted elements would not be in order, panics with ErrUnordered, keeping the SortedList unaltered.
+func (s *SortedList[T]) Splice(start, deleteCount int, elements ...T) IList[T] {
+	length := s.Length()
+	if start < 0 || start > length {
+		panic(outOfRange(start, length))
+	}
+	if deleteCount > length-start {
+		deleteCount = length - start
+	}
+	if deleteCount < 0 {
+		deleteCount = 0
+	}
+	s.ensureOrdered(s.l.Elements()[:start], elements, s.l.Elements()[start+deleteCount:])
+	return s.l.Splice(start, deleteCount, elements...)
+}
+
+// RemoveWhere removes all the elements which satisfies the predicate, and then returns itself.
+func (s *SortedList[T]) RemoveWhere(handler Predicate[T]) IList[T] {
+	s.l.RemoveWhere(handler)
+	return s
+}
+
+// Interval returns a new List with all elements between the *from* and *to* indexes.
+// If the interval is not within the SortedList bounds, panics.
+func (s *SortedList[T]) Interval(from, to int) IList[T] {
+	return s.l.Interval(from, to)
+}
+
+// TryInterval returns a new List with all elements between the *from* and *to* indexes.
+// If the interval is not within the SortedList bounds, ErrIndexOutOfRange is returned.
+func (s *SortedList[T]) TryInterval(from, to int) (IList[T], error) {
+	return s.l.TryInterval(from, to)
+}
+
+// Slice returns a new List with the elements from the *from* index (inclusive) to the *to* index (exclusive), taking every *step* element.
+// Negative indexes are counted from the end of the SortedList (-1 is the last element), and out-of-range bounds are clamped.
+// A negative step walks backwards, from *from* down to *to*. A zero step panics with ErrZeroStep.
+func (s *SortedList[T]) Slice(from, to, step int) IList[T] {
+	return s.l.Slice(from, to, step)
+}
+
+// String returns a string representation of the SortedList.
+func (s *SortedList[T]) String() string {
+	return s.l.String()
+}
+
+// Join returns the string representation of each element in the IList, separated by the given separator
+func (s *SortedList[T]) Join(separator string) string {
+	return s.l.Join(separator)
+}
+
+// Sort receives a Sorter function to sort its elements, and returns itself after sorted.
+// The SortedList adopts the given Sorter, keeping its elements ordered by it from now on.
+// Sort is stable: elements considered equal by the Sorter keep their original order.
+func (s *SortedList[T]) Sort(sorter Sorter[T]) IList[T] {
+	s.sorter = sorter
+	s.l.Sort(sorter)
+	return s
+}
+
+// Clear removes all elements from the SortedList, making it empty, and then returns itself.
+func (s *SortedList[T]) Clear() IList[T] {
+	s.l.Clear()
+	return s
+}
+
+// IsDynamicallySized returns true, as SortedList is a dynamically-sized implementation of IList
+func (s *SortedList[T]) IsDynamicallySized() bool {
+	return true
+}
+
+// IsThreadSafe returns false, as SortedList is not a thread-safe implementation of IList
+func (s *SortedList[T]) IsThreadSafe() bool {
+	return false
+}
+
+func (s *SortedList[T]) UnmarshalJSON(data []byte) error {
+	var elements []T
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return err
+	}
+	s.Clear().Push(elements...)
+	return nil
+}
+
+func (s *SortedList[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.l)
+}
+
+// bound returns the index of the first element which satisfies the predicate, by binary search.
+// The predicate must be false for a prefix of the SortedList and true for the remaining elements.
+func (s *SortedList[T]) bound(handler Predicate[T]) int {
+	low, high := 0, s.Length()
+	for low < high {
+		mid := int(uint(low+high) >> 1)
+		if handler(s.l.Elements()[mid]) {
+			high = mid
+		} else {
+			low = mid + 1
+		}
+	}
+	return low
+}
+
+// ensureOrdered panics with ErrUnordered if the given elements, placed between before and after, are not in order.
+// Both before and after must already be in order.
+func (s *SortedList[T]) ensureOrdered(before, elements, after []T) {
+	candidates := make([]T, 0, len(elements)+2)
+	if len(before) > 0 {
+		candidates = append(candidates, before[len(before)-1])
+	}
+	candidates = append(candidates, elements...)
+	if len(after) > 0 {
+		candidates = append(candidates, after[0])
+	}
+	for i := 1; i < len(candidates); i++ {
+		if s.sorter(candidates[i-1], candidates[i]) > 0 {
+			panic(ErrUnordered)
+		}
+	}
+}
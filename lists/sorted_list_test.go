@@ -0,0 +1,138 @@
+package lists
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+type pair struct {
+	key   int
+	value string
+}
+
+func ascending(a, b int) int {
+	return a - b
+}
+
+func TestSortedList_Push(t *testing.T) {
+	list := NewSortedList(ascending, 5, 1, 4)
+	list.Push(3).Push(6, 0, 2)
+	if list.Join(",") != "0,1,2,3,4,5,6" {
+		t.Errorf("SortedList should keep its elements in order. Got: %v", list)
+	}
+	pairs := NewSortedList(func(a, b pair) int {
+		return a.key - b.key
+	}, pair{1, "a"}, pair{0, "b"}, pair{1, "c"})
+	pairs.Push(pair{1, "d"}).Push(pair{0, "e"}, pair{1, "f"})
+	joined := Fold[pair, string](pairs, func(joined string, v pair, i int) string {
+		return joined + v.value
+	}, "")
+	if joined != "beacdf" {
+		t.Errorf("SortedList should keep equal elements in push order. Got: %v", joined)
+	}
+}
+
+func TestSortedList_Search(t *testing.T) {
+	list := NewSortedList(ascending, 1, 3, 3, 3, 5, 7)
+	tests := []struct {
+		element, index, lower, upper int
+		found                        bool
+	}{
+		{0, 0, 0, 0, false},
+		{1, 0, 0, 1, true},
+		{3, 1, 1, 4, true},
+		{4, 4, 4, 4, false},
+		{7, 5, 5, 6, true},
+		{8, 6, 6, 6, false},
+	}
+	for _, test := range tests {
+		index, found := list.Search(test.element)
+		if index != test.index || found != test.found || list.Contains(test.element) != test.found {
+			t.Errorf("Search(%v): expected %v, %v. Got: %v, %v", test.element, test.index, test.found, index, found)
+		}
+		if lower, upper := list.LowerBound(test.element), list.UpperBound(test.element); lower != test.lower || upper != test.upper {
+			t.Errorf("Bounds(%v): expected %v, %v. Got: %v, %v", test.element, test.lower, test.upper, lower, upper)
+		}
+	}
+}
+
+func TestSortedList_Between(t *testing.T) {
+	list := NewSortedList(ascending, 1, 3, 3, 5, 7, 9)
+	tests := map[[2]int]string{
+		{3, 7}:   "3,3,5,7",
+		{2, 8}:   "3,3,5,7",
+		{0, 100}: "1,3,3,5,7,9",
+		{4, 4}:   "",
+		{7, 3}:   "",
+		{10, 20}: "",
+	}
+	for bounds, expected := range tests {
+		if between := list.Between(bounds[0], bounds[1]); between.Join(",") != expected {
+			t.Errorf("Between(%v, %v): expected %v. Got: %v", bounds[0], bounds[1], expected, between)
+		}
+	}
+}
+
+func TestSortedList_Ordering(t *testing.T) {
+	list := NewSortedList(ascending, 1, 3, 5)
+	if _, err := list.TrySet(1, 6); !errors.Is(err, ErrUnordered) {
+		t.Errorf("TrySet should return ErrUnordered. Got: %v", err)
+	}
+	if _, err := list.TrySet(3, 6); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Errorf("TrySet should return ErrIndexOutOfRange. Got: %v", err)
+	}
+	list.Set(1, 4).InsertAt(3, 5, 6).InsertAt(0, 0)
+	removed := list.Splice(1, 2, 2, 3)
+	if list.Join(",") != "0,2,3,5,5,6" || removed.Join(",") != "1,4" {
+		t.Errorf("SortedList should accept ordered changes. Got: %v and %v", list, removed)
+	}
+	rejected := map[string]func(){
+		"Set":            func() { list.Set(0, 3) },
+		"InsertAt":       func() { list.InsertAt(1, 4) },
+		"InsertAt.Order": func() { list.InsertAt(6, 8, 7) },
+		"Splice":         func() { list.Splice(0, 1, 9) },
+	}
+	for name, change := range rejected {
+		func() {
+			defer func() {
+				if r := recover(); r != ErrUnordered {
+					t.Errorf("%v should panic with ErrUnordered. Got: %v", name, r)
+				}
+			}()
+			change()
+		}()
+	}
+	if list.Join(",") != "0,2,3,5,5,6" {
+		t.Errorf("SortedList should be kept unaltered by rejected changes. Got: %v", list)
+	}
+}
+
+func TestSortedList_Sort(t *testing.T) {
+	list := NewSortedList(ascending, 2, 3, 1)
+	cloned := list.Clone()
+	list.Sort(func(a, b int) int {
+		return b - a
+	}).Push(4, 0)
+	if list.Join(",") != "4,3,2,1,0" || !list.Contains(0) {
+		t.Errorf("SortedList should adopt the Sorter given to Sort. Got: %v", list)
+	}
+	if cloned.Push(0).Join(",") != "0,1,2,3" {
+		t.Errorf("SortedList clones should keep the Sorter. Got: %v", cloned)
+	}
+}
+
+func TestSortedList_JSON(t *testing.T) {
+	list := NewSortedList[string](strings.Compare)
+	if err := json.Unmarshal([]byte(`["c", "a", "b"]`), list); err != nil {
+		t.Fatal(err)
+	}
+	bytes, err := json.Marshal(list)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(bytes) != `["a","b","c"]` {
+		t.Errorf("SortedList should be unmarshalled in order. Got: %s", bytes)
+	}
+}
@@ -0,0 +1,163 @@
+package lists
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// Ascending returns a Sorter which orders elements from the smallest to the greatest. NaN values come first.
+func Ascending[T Ordered]() Sorter[T] {
+	return compare[T]
+}
+
+// Descending returns a Sorter which orders elements from the greatest to the smallest. NaN values come last.
+func Descending[T Ordered]() Sorter[T] {
+	return Reverse(Ascending[T]())
+}
+
+// By returns a Sorter which orders elements by the ascending order of the key extracted from them.
+func By[T any, K Ordered](key TypeMapper[T, K]) Sorter[T] {
+	return ByFunc(key, Ascending[K]())
+}
+
+// ByFunc returns a Sorter which orders elements by the key extracted from them, using the given Sorter for the keys.
+func ByFunc[T, K any](key TypeMapper[T, K], sorter Sorter[K]) Sorter[T] {
+	return func(a, b T) int {
+		return sorter(key(a), key(b))
+	}
+}
+
+// Reverse returns a Sorter which orders elements in the opposite order of the given one.
+func Reverse[T any](sorter Sorter[T]) Sorter[T] {
+	return func(a, b T) int {
+		return sorter(b, a)
+	}
+}
+
+// ThenBy returns a Sorter which orders elements by the Sorter, and then, for elements it considers equal, by the given one.
+func (sorter Sorter[T]) ThenBy(next Sorter[T]) Sorter[T] {
+	return func(a, b T) int {
+		if order := sorter(a, b); order != 0 {
+			return order
+		}
+		return next(a, b)
+	}
+}
+
+// ThenByDescending returns a Sorter which orders elements by the Sorter, and then, for elements it considers equal,
+// in the opposite order of the given one.
+func (sorter Sorter[T]) ThenByDescending(next Sorter[T]) Sorter[T] {
+	return sorter.ThenBy(Reverse(next))
+}
+
+// NullsFirst returns a Sorter for pointers which places nil pointers first, and orders the others by the pointed values.
+func NullsFirst[T any](sorter Sorter[T]) Sorter[*T] {
+	return nulls(sorter, -1)
+}
+
+// NullsLast returns a Sorter for pointers which places nil pointers last, and orders the others by the pointed values.
+func NullsLast[T any](sorter Sorter[T]) Sorter[*T] {
+	return nulls(sorter, 1)
+}
+
+// CaseInsensitive returns a Sorter which orders strings alphabetically, regardless of letter case.
+func CaseInsensitive() Sorter[string] {
+	return func(a, b string) int {
+		for a != "" && b != "" {
+			ra, sizeA := utf8.DecodeRuneInString(a)
+			rb, sizeB := utf8.DecodeRuneInString(b)
+			if order := compare(unicode.ToLower(ra), unicode.ToLower(rb)); order != 0 {
+				return order
+			}
+			a, b = a[sizeA:], b[sizeB:]
+		}
+		return compare(len(a), len(b))
+	}
+}
+
+// Natural returns a Sorter which orders strings alphabetically, except for runs of digits, which are compared by their numeric value,
+// so "file2" comes before "file10". Strings which differ only in leading zeros are ordered by their bytes.
+func Natural() Sorter[string] {
+	return func(a, b string) int {
+		x, y := a, b
+		for x != "" && y != "" {
+			if isDigit(x[0]) && isDigit(y[0]) {
+				var numberX, numberY string
+				numberX, x = digits(x)
+				numberY, y = digits(y)
+				if order := compare(len(numberX), len(numberY)); order != 0 {
+					return order
+				}
+				if order := compare(numberX, numberY); order != 0 {
+					return order
+				}
+				continue
+			}
+			rx, sizeX := utf8.DecodeRuneInString(x)
+			ry, sizeY := utf8.DecodeRuneInString(y)
+			if order := compare(rx, ry); order != 0 {
+				return order
+			}
+			x, y = x[sizeX:], y[sizeY:]
+		}
+		if order := compare(len(x), len(y)); order != 0 {
+			return order
+		}
+		return compare(a, b)
+	}
+}
+
+// compare returns -1 if a is less than b, 1 if a is greater than b, and 0 if they are equal. NaN is less than any other value.
+func compare[T Ordered](a, b T) int {
+	aNaN, bNaN := a != a, b != b
+	switch {
+	case aNaN || bNaN:
+		return compare(boolToInt(!aNaN), boolToInt(!bNaN))
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// boolToInt returns 1 if b is true, and 0 otherwise.
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// nulls returns a Sorter for pointers which orders nil pointers according to nilOrder, and the others by the pointed values.
+func nulls[T any](sorter Sorter[T], nilOrder int) Sorter[*T] {
+	return func(a, b *T) int {
+		switch {
+		case a == nil && b == nil:
+			return 0
+		case a == nil:
+			return nilOrder
+		case b == nil:
+			return -nilOrder
+		}
+		return sorter(*a, *b)
+	}
+}
+
+// isDigit returns true if the given byte is an ASCII digit.
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+// digits splits the given string after its leading run of digits, and returns the run without its leading zeros, and the rest.
+func digits(s string) (string, string) {
+	start := 0
+	for start < len(s) && s[start] == '0' {
+		start++
+	}
+	end := start
+	for end < len(s) && isDigit(s[end]) {
+		end++
+	}
+	return s[start:end], s[end:]
+}
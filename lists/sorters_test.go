@@ -0,0 +1,82 @@
+package lists
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+type employee struct {
+	name string
+	team string
+	age  *int
+}
+
+func (e employee) String() string {
+	return e.name
+}
+
+func TestSorters_Ordered(t *testing.T) {
+	if sorted := NewList(3, 1, 2).Sort(Ascending[int]()); sorted.Join(",") != "1,2,3" {
+		t.Errorf("Ascending should sort from the smallest. Got: %v", sorted)
+	}
+	if sorted := NewList("b", "c", "a").Sort(Descending[string]()); sorted.Join(",") != "c,b,a" {
+		t.Errorf("Descending should sort from the greatest. Got: %v", sorted)
+	}
+	if sorted := NewList(1, math.NaN(), -1).Sort(Ascending[float64]()); sorted.String() != "[NaN -1 1]" {
+		t.Errorf("Ascending should place NaN first. Got: %v", sorted)
+	}
+	if sorted := NewList(3, 1, 2).Sort(Reverse(Descending[int]())); sorted.Join(",") != "1,2,3" {
+		t.Errorf("Reverse should invert the Sorter. Got: %v", sorted)
+	}
+}
+
+func TestSorters_Chaining(t *testing.T) {
+	one, two := 1, 2
+	employees := NewList(
+		employee{name: "dan", team: "b", age: &two},
+		employee{name: "ann", team: "a"},
+		employee{name: "bob", team: "b", age: &one},
+		employee{name: "cid", team: "a", age: &two},
+		employee{name: "eve", team: "b"},
+	)
+	team := By(func(e employee) string { return e.team })
+	name := By(func(e employee) string { return e.name })
+	age := func(e employee) *int { return e.age }
+	tests := map[string]Sorter[employee]{
+		"ann,cid,bob,dan,eve": team.ThenBy(name),
+		"cid,ann,eve,dan,bob": team.ThenByDescending(name),
+		"ann,eve,bob,cid,dan": ByFunc(age, NullsFirst(Ascending[int]())).ThenBy(name),
+		"bob,cid,dan,ann,eve": ByFunc(age, NullsLast(Ascending[int]())).ThenBy(name),
+		"ann,cid,eve,dan,bob": team.ThenBy(ByFunc(age, NullsFirst(Descending[int]()))),
+	}
+	for expected, sorter := range tests {
+		if sorted := employees.Clone().Sort(sorter); sorted.Join(",") != expected {
+			t.Errorf("Expected %v. Got: %v", expected, sorted)
+		}
+	}
+}
+
+func TestSorters_Strings(t *testing.T) {
+	tests := []struct {
+		sorter   Sorter[string]
+		elements []string
+		expected string
+	}{
+		{CaseInsensitive(), []string{"banana", "Apple", "cherry", "apple", "Ap"}, "Ap,Apple,apple,banana,cherry"},
+		{Natural(), []string{"file10", "file2", "file1", "file", "file02b", "file2a"}, "file,file1,file2,file2a,file02b,file10"},
+		{Natural(), []string{"a01", "a1", "a001", "a0"}, "a0,a001,a01,a1"},
+		{Natural(), []string{"v1.10.0", "v1.9.2", "v1.9.10"}, "v1.9.2,v1.9.10,v1.10.0"},
+		{Natural(), []string{"99999999999999999999", "100000000000000000000", "x"}, "99999999999999999999,100000000000000000000,x"},
+	}
+	for _, test := range tests {
+		if sorted := NewList(test.elements...).Sort(test.sorter); sorted.Join(",") != test.expected {
+			t.Errorf("Expected %v. Got: %v", test.expected, sorted)
+		}
+	}
+	for _, pair := range [][2]string{{"file2", "file10"}, {"", "a"}, {"f", "é"}} {
+		if order := fmt.Sprint(Natural()(pair[0], pair[1]), Natural()(pair[1], pair[0])); order != "-1 1" {
+			t.Errorf("Natural should order %q before %q. Got: %v", pair[0], pair[1], order)
+		}
+	}
+}
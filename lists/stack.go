@@ -0,0 +1,85 @@
+package lists
+
+import (
+	"fmt"
+)
+
+// NewStack returns a new Stack with the given elements, pushed in order: the last one is the top of the Stack
+func NewStack[T any](elements ...T) *Stack[T] {
+	return &Stack[T]{d: NewDeque(elements...)}
+}
+
+// NewSafeStack returns a new thread-safe Stack with the given elements, pushed in order: the last one is the top of the Stack
+func NewSafeStack[T any](elements ...T) *Stack[T] {
+	return &Stack[T]{d: NewSafeDeque(elements...)}
+}
+
+// Stack is a last-in-first-out collection, backed by a Deque (or a SafeDeque, see NewSafeStack).
+// Push, Pop and Peek run in amortized O(1). The zero value is an empty Stack, backed by a Deque.
+type Stack[T any] struct {
+	d deque[T]
+}
+
+// deque returns the deque backing the Stack, creating an empty Deque for the zero value.
+func (s *Stack[T]) deque() deque[T] {
+	if s.d == nil {
+		s.d = &Deque[T]{}
+	}
+	return s.d
+}
+
+// Length returns how many elements are in the Stack.
+func (s *Stack[T]) Length() int {
+	return s.deque().Length()
+}
+
+// IsEmpty returns true if there are *no* elements stored in the Stack.
+func (s *Stack[T]) IsEmpty() bool {
+	return s.Length() == 0
+}
+
+// IsNotEmpty returns true if there are elements stored in the Stack.
+func (s *Stack[T]) IsNotEmpty() bool {
+	return s.Length() > 0
+}
+
+// Push adds the given elements on top of the Stack, in order: the last one becomes the top.
+func (s *Stack[T]) Push(elements ...T) {
+	s.deque().PushBack(elements...)
+}
+
+// Pop removes the element on top of the Stack, and returns it and true.
+// If the Stack is empty, the zero value and false are returned.
+func (s *Stack[T]) Pop() (T, bool) {
+	return s.deque().PopBack()
+}
+
+// Peek returns the element on top of the Stack and true, without removing it.
+// If the Stack is empty, the zero value and false are returned.
+func (s *Stack[T]) Peek() (T, bool) {
+	return s.deque().PeekBack()
+}
+
+// Clear removes all elements from the Stack, making it empty.
+func (s *Stack[T]) Clear() {
+	s.deque().Clear()
+}
+
+// ToList returns a new IList with the elements of the Stack in push order, so its last element is the top of the Stack.
+// Thread-safe Stacks return a SafeList.
+func (s *Stack[T]) ToList() IList[T] {
+	if s.deque().IsThreadSafe() {
+		return NewSafeListFrom(s.deque().Elements())
+	}
+	return NewListFrom(s.deque().Elements())
+}
+
+// String returns a string representation of the Stack, in push order.
+func (s *Stack[T]) String() string {
+	return fmt.Sprint(s.deque().Elements())
+}
+
+// IsThreadSafe returns true if the Stack was created by NewSafeStack
+func (s *Stack[T]) IsThreadSafe() bool {
+	return s.deque().IsThreadSafe()
+}
@@ -0,0 +1,57 @@
+package lists
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestStack(t *testing.T) {
+	s := NewStack(1, 2)
+	s.Push(3, 4)
+	top, _ := s.Peek()
+	popped, ok := s.Pop()
+	if top != 4 || popped != 4 || !ok || s.String() != "[1 2 3]" || s.Length() != 3 {
+		t.Errorf("Stack should pop the last pushed element. Got: %v", s)
+	}
+	if list := s.ToList(); list.Pop().String() != "[1 2]" || list.IsThreadSafe() {
+		t.Errorf("Stack.ToList should keep the push order. Got: %v", list)
+	}
+	s.Clear()
+	if v, ok := s.Pop(); ok || v != 0 || s.IsNotEmpty() {
+		t.Errorf("Pop should be false when empty. Got: %v, %v", v, ok)
+	}
+	if _, ok := s.Peek(); ok {
+		t.Error("Peek should be false when empty")
+	}
+}
+
+func TestStack_ZeroValue(t *testing.T) {
+	var s Stack[int]
+	if s.Length() != 0 || s.IsThreadSafe() || s.String() != "[]" {
+		t.Errorf("The zero value should be an empty Stack. Got: %v", s.String())
+	}
+	s.Push(1, 2)
+	if top, ok := s.Pop(); top != 2 || !ok || s.ToList().String() != "[1]" {
+		t.Errorf("The zero value should be usable. Got: %v, %v and %v", top, ok, s.String())
+	}
+}
+
+func TestSafeStack(t *testing.T) {
+	s := NewSafeStack[int]()
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				s.Push(j)
+				s.Pop()
+				s.Push(j)
+			}
+		}()
+	}
+	wg.Wait()
+	if s.Length() != 800 || !s.IsThreadSafe() || !s.ToList().IsThreadSafe() {
+		t.Errorf("SafeStack should keep every pushed element. Got: %v", s.Length())
+	}
+}
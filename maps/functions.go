@@ -1,3 +1,9 @@
 package maps
 
 type Predicate[K comparable, V any] func(K, V) bool
+
+// Entry is a key/value pair stored in an IMap.
+type Entry[K comparable, V any] struct {
+	Key   K
+	Value V
+}
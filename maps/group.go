@@ -0,0 +1,82 @@
+package maps
+
+import (
+	"errors"
+
+	"github.com/tmontdev/collections/lists"
+)
+
+// ErrDuplicateKey is returned when two elements have the same key, and the DuplicatePolicy is RejectDuplicates.
+var ErrDuplicateKey = errors.New("maps: duplicate key")
+
+// DuplicatePolicy defines which value is kept when two elements have the same key.
+type DuplicatePolicy int
+
+const (
+	// KeepLast keeps the value of the last element with the key. It is the default DuplicatePolicy.
+	KeepLast DuplicatePolicy = iota
+
+	// KeepFirst keeps the value of the first element with the key.
+	KeepFirst
+
+	// RejectDuplicates makes the operation fail with ErrDuplicateKey.
+	RejectDuplicates
+)
+
+// GroupBy returns a new Map with the elements of the given lists.IList grouped by the key extracted from each one.
+// Each group is a lists.List, which keeps the order of the elements in the given lists.IList.
+func GroupBy[T any, K comparable](list lists.IList[T], key lists.TypeMapper[T, K]) IMap[K, lists.IList[T]] {
+	groups := Map[K, lists.IList[T]]{}
+	lists.NewSeq(list)(func(element T) bool {
+		k := key(element)
+		if !groups.Has(k) {
+			groups.Set(k, lists.NewList[T]())
+		}
+		groups.Get(k).Push(element)
+		return true
+	})
+	return groups
+}
+
+// IndexBy returns a new Map with the elements of the given lists.IList, stored in the key extracted from each one.
+// Elements with the same key are handled by the given DuplicatePolicy.
+func IndexBy[T any, K comparable](list lists.IList[T], key lists.TypeMapper[T, K], policy DuplicatePolicy) (IMap[K, T], error) {
+	return Associate(list, func(element T) (K, T) {
+		return key(element), element
+	}, policy)
+}
+
+// Associate returns a new Map with the key/value pairs extracted from each element of the given lists.IList.
+// Elements with the same key are handled by the given DuplicatePolicy.
+func Associate[T any, K comparable, V any](list lists.IList[T], associate func(T) (K, V), policy DuplicatePolicy) (IMap[K, V], error) {
+	associated := Map[K, V]{}
+	var err error
+	lists.NewSeq(list)(func(element T) bool {
+		k, v := associate(element)
+		if associated.Has(k) {
+			switch policy {
+			case KeepFirst:
+				return true
+			case RejectDuplicates:
+				err = ErrDuplicateKey
+				return false
+			}
+		}
+		associated.Set(k, v)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return associated, nil
+}
+
+// CountBy returns a new Map with how many elements of the given lists.IList have each key.
+func CountBy[T any, K comparable](list lists.IList[T], key lists.TypeMapper[T, K]) IMap[K, int] {
+	counts := Map[K, int]{}
+	lists.NewSeq(list)(func(element T) bool {
+		counts[key(element)]++
+		return true
+	})
+	return counts
+}
@@ -0,0 +1,50 @@
+package maps
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/tmontdev/collections/lists"
+)
+
+func TestGroupBy(t *testing.T) {
+	groups := GroupBy[string](lists.NewList("foo", "ab", "bar", "cd", "e"), func(word string) int {
+		return len(word)
+	})
+	if groups.Length() != 3 || groups.Get(3).Join(",") != "foo,bar" || groups.Get(2).Join(",") != "ab,cd" || groups.Get(1).Join(",") != "e" {
+		t.Errorf("GroupBy: unexpected groups %v", groups)
+	}
+}
+
+func TestIndexBy(t *testing.T) {
+	users := lists.NewList(User{ID: 1, Name: "foo"}, User{ID: 2, Name: "bar"}, User{ID: 1, Name: "baz"})
+	id := func(u User) int {
+		return u.ID
+	}
+	last, err := IndexBy[User](users, id, KeepLast)
+	if err != nil || last.Length() != 2 || last.Get(1).Name != "baz" {
+		t.Errorf("IndexBy.KeepLast: unexpected result %v, %v", last, err)
+	}
+	first, err := IndexBy[User](users, id, KeepFirst)
+	if err != nil || first.Get(1).Name != "foo" {
+		t.Errorf("IndexBy.KeepFirst: unexpected result %v, %v", first, err)
+	}
+	if _, err = IndexBy[User](users, id, RejectDuplicates); !errors.Is(err, ErrDuplicateKey) {
+		t.Errorf("IndexBy.RejectDuplicates: expected ErrDuplicateKey. Got: %v", err)
+	}
+	names, err := Associate[User](users, func(u User) (string, int) {
+		return u.Name, u.ID
+	}, RejectDuplicates)
+	if err != nil || names.Get("bar") != 2 {
+		t.Errorf("Associate: unexpected result %v, %v", names, err)
+	}
+}
+
+func TestCountBy(t *testing.T) {
+	counts := CountBy[int](lists.NewSafeList(1, 2, 3, 4, 5), func(v int) bool {
+		return v%2 == 0
+	})
+	if counts.Get(true) != 2 || counts.Get(false) != 3 {
+		t.Errorf("CountBy: unexpected counts %v", counts)
+	}
+}
@@ -0,0 +1,36 @@
+package maps
+
+import "github.com/tmontdev/collections/lists"
+
+// NewSeq returns a new lazy lists.Seq over the key/value pairs of the given IMap, as Entry.
+// The IMap is read each time a terminal operation is called, and the order of the entries is not specified.
+func NewSeq[K comparable, V any](source IMap[K, V]) lists.Seq[Entry[K, V]] {
+	return func(yield func(Entry[K, V]) bool) {
+		for k, v := range source.Builtin() {
+			if !yield(Entry[K, V]{Key: k, Value: v}) {
+				return
+			}
+		}
+	}
+}
+
+// FromSeq evaluates the given lists.Seq and returns a new Map with its entries.
+// On key conflict, the last entry is kept.
+func FromSeq[K comparable, V any](seq lists.Seq[Entry[K, V]]) IMap[K, V] {
+	return ToMap(seq, func(e Entry[K, V]) K {
+		return e.Key
+	}, func(e Entry[K, V]) V {
+		return e.Value
+	})
+}
+
+// ToMap evaluates the given lists.Seq and returns a new Map, with keys and values extracted from each element.
+// On key conflict, the last element is kept.
+func ToMap[T any, K comparable, V any](seq lists.Seq[T], key lists.TypeMapper[T, K], value lists.TypeMapper[T, V]) IMap[K, V] {
+	m := Map[K, V]{}
+	seq(func(element T) bool {
+		m[key(element)] = value(element)
+		return true
+	})
+	return m
+}
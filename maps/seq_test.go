@@ -0,0 +1,25 @@
+package maps
+
+import (
+	"testing"
+
+	"github.com/tmontdev/collections/lists"
+)
+
+func TestSeq_ToMap(t *testing.T) {
+	source := Map[string, int]{"a": 1, "b": 2, "c": 3}
+	odds := FromSeq(NewSeq[string, int](source).Where(func(e Entry[string, int]) bool {
+		return e.Value%2 == 1
+	}))
+	if odds.Length() != 2 || odds.Get("a") != 1 || odds.Get("c") != 3 {
+		t.Errorf("FromSeq: expected map[a:1 c:3]. Got: %v", odds)
+	}
+	lengths := ToMap(lists.NewSeqFrom([]string{"foo", "ab"}), func(v string) string {
+		return v
+	}, func(v string) int {
+		return len(v)
+	})
+	if lengths.Get("foo") != 3 || lengths.Get("ab") != 2 {
+		t.Errorf("ToMap: expected map[ab:2 foo:3]. Got: %v", lengths)
+	}
+}